@@ -0,0 +1,584 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	adminreports "google.golang.org/api/admin/reports/v1"
+)
+
+const (
+	// Durée de rétention des journaux d'activité par l'API Admin Reports
+	adminReportsActivityRetentionDays = 180
+	// Durée de rétention des rapports d'utilisation par l'API Admin Reports
+	adminReportsUsageRetentionDays = 450
+)
+
+// adminReportsLookbackDays retourne la fenêtre d'interrogation par défaut (en jours) définie par
+// l'option de connexion admin_reports_default_lookback_days, ou defaultDays si elle n'est pas renseignée.
+// Une valeur négative, nulle ou supérieure à la rétention de l'API est refusée.
+func adminReportsLookbackDays(d *plugin.QueryData, defaultDays int, retentionDays int) (int, error) {
+	config := GetConfig(d.Connection)
+	if config.AdminReportsDefaultLookbackDays == nil {
+		return defaultDays, nil
+	}
+
+	days := *config.AdminReportsDefaultLookbackDays
+	if days <= 0 {
+		return 0, fmt.Errorf("admin_reports_default_lookback_days must be greater than 0, got %d", days)
+	}
+	if days > retentionDays {
+		return 0, fmt.Errorf("admin_reports_default_lookback_days (%d) exceeds the Reports API retention of %d days", days, retentionDays)
+	}
+	return days, nil
+}
+
+//// TABLE DEFINITION
+
+// adminReportsActivityKeyColumns retourne les qualifiers communs aux tables d'activité Admin Reports.
+func adminReportsActivityKeyColumns() plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{Name: "time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+		{Name: "actor_email", Require: plugin.Optional},
+		{Name: "ip_address", Require: plugin.Optional},
+		{Name: "event_name", Require: plugin.Optional},
+		{Name: "filters", Require: plugin.Optional},
+		{Name: "org_unit_id", Require: plugin.Optional},
+		{Name: "group_id", Require: plugin.Optional},
+	}
+}
+
+// adminReportsActivityColumns ajoute les colonnes spécifiques à une application
+// après les colonnes communes à toutes les tables d'activité Admin Reports.
+func adminReportsActivityColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(commonAdminReportsActivityColumns(), columns...)
+}
+
+func commonAdminReportsActivityColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "time",
+			Description: "Horodatage de l'activité (ID.Time) au format RFC3339",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Id.Time"),
+		},
+		{
+			Name:        "actor_email",
+			Description: "Adresse email de l'acteur (Actor.Email)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Actor.Email"),
+		},
+		{
+			Name:        "event_name",
+			Description: "Nom du premier événement de l’activité",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Events").Transform(extractFirstEventName),
+		},
+		{
+			Name:        "event_type",
+			Description: "Type du premier événement de l’activité",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Events").Transform(extractFirstEventType),
+		},
+		{
+			Name:        "unique_qualifier",
+			Description: "Identifiant unique qualifiant cette activité (ID.UniqueQualifier)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Id.UniqueQualifier"),
+		},
+		{
+			Name:        "application_name",
+			Description: "Nom de l’application du rapport (ID.ApplicationName)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Id.ApplicationName"),
+		},
+		{
+			Name:        "actor_profile_id",
+			Description: "Profile ID de l'acteur (Actor.ProfileId)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Actor.ProfileId"),
+		},
+		{
+			Name:        "actor_caller_type",
+			Description: "Type de caller (Actor.CallerType)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Actor.CallerType"),
+		},
+		{
+			Name:        "ip_address",
+			Description: "Adresse IP associée à l’activité (IpAddress)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("IpAddress"),
+		},
+		{
+			Name:        "events",
+			Description: "Liste des événements détaillés (Events) pour cette activité, en JSON",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("Events"),
+		},
+		{
+			Name:        "event_parameters",
+			Description: "Paramètres des événements aplatis en JSON (nom → valeur)",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.From(adminReportsEventParameters),
+		},
+		{
+			Name:        "filters",
+			Description: "Expression de filtre sur les paramètres d’événements transmise à l’API (ex: 'doc_type==document,visibility<>private')",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("filters"),
+		},
+		{
+			Name:        "org_unit_id",
+			Description: "Identifiant de l’unité organisationnelle transmis à l’API pour restreindre les activités à ses utilisateurs (une seule valeur)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("org_unit_id"),
+		},
+		{
+			Name:        "group_id",
+			Description: "Identifiant de groupe transmis à l’API pour restreindre les activités aux acteurs membres de ce groupe (une seule valeur)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("group_id"),
+		},
+		{
+			Name:        "title",
+			Description: "Titre de l’activité (Time + Actor Email)",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Id.Time").Transform(convertTimeToString).Transform(formatTitleWithActorEmail),
+		},
+		{
+			Name:        "tags",
+			Description: "Tags pour classification (liste des noms d’événements)",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("Events").Transform(extractEventNames),
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// listAdminReportsActivities liste les activités de l'application Admin Reports donnée.
+// Elle gère les qualifiers : time (via StartTime/EndTime), actor_email (via userKey),
+// ip_address (via ActorIpAddress), event_name, filters, org_unit_id et group_id. Les listes IN sur actor_email
+// et event_name sont également transmises à l’API ; org_unit_id et group_id n’acceptent qu’une valeur.
+func listAdminReportsActivities(ctx context.Context, d *plugin.QueryData, applicationName string) (interface{}, error) {
+	logPrefix := "gcp_admin_reports_" + applicationName + "_activity.listAdminReportsActivities"
+
+	// Création du service Reports API
+	service, err := ReportsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(logPrefix, "service_error", err)
+		return nil, err
+	}
+
+	// 1. Gestion de la plage temporelle
+	lookbackDays, err := adminReportsLookbackDays(d, adminReportsActivityRetentionDays, adminReportsActivityRetentionDays)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	startTime := now.AddDate(0, 0, -lookbackDays)
+	endTime := now
+	if quals := d.Quals["time"]; quals != nil {
+		for _, q := range quals.Quals {
+			if q.Value != nil && q.Value.GetTimestampValue() != nil {
+				t := q.Value.GetTimestampValue().AsTime()
+				switch q.Operator {
+				case "=":
+					startTime = t
+					endTime = t
+				case ">":
+					startTime = t.Add(time.Nanosecond)
+				case ">=":
+					startTime = t
+				case "<", "<=":
+					endTime = t
+				}
+			}
+		}
+	}
+	if startTime.After(endTime) {
+		return nil, nil
+	}
+	// Une journée de tolérance évite de rejeter "time > now() - interval '180 days'"
+	if startTime.Before(now.AddDate(0, 0, -adminReportsActivityRetentionDays-1)) {
+		return nil, fmt.Errorf("requested start time %s exceeds the Reports API retention of %d days for activities", startTime.Format(time.RFC3339), adminReportsActivityRetentionDays)
+	}
+
+	// org_unit_id et group_id sont renvoyés tels quels dans les colonnes (FromQual) :
+	// une liste IN ne peut pas être rapprochée de chaque activité, elle est donc refusée.
+	for _, column := range []string{"org_unit_id", "group_id"} {
+		if qual := d.EqualsQuals[column]; qual != nil && qual.GetListValue() != nil {
+			return nil, fmt.Errorf("%s only supports a single value, IN lists are not supported", column)
+		}
+	}
+
+	// 2. Utilisateurs et noms d’événements : l’API n’accepte qu’une valeur par appel,
+	// une liste IN donne donc lieu à un appel par valeur.
+	// Si actor_email est fourni, on interroge directement l’activité de cet utilisateur.
+	userKeys := adminReportsActivityQualValues(d, "actor_email")
+	eventNames := adminReportsActivityQualValues(d, "event_name")
+
+	requests := [][2]string{}
+	for _, userKey := range userKeys {
+		if userKey == "" {
+			userKey = "all"
+		}
+		for _, eventName := range eventNames {
+			requests = append(requests, [2]string{userKey, eventName})
+		}
+	}
+
+	// Une activité peut correspondre à plusieurs appels : on évite de la retourner deux fois
+	seen := map[string]bool{}
+
+	for _, request := range requests {
+		userKey, eventName := request[0], request[1]
+		call := service.Activities.List(userKey, applicationName).
+			StartTime(startTime.Format(time.RFC3339)).
+			EndTime(endTime.Format(time.RFC3339))
+		if eventName != "" {
+			call.EventName(eventName)
+		}
+
+		// Filtre sur l’adresse IP de l’acteur
+		if ipAddress := d.EqualsQualString("ip_address"); ipAddress != "" {
+			call.ActorIpAddress(ipAddress)
+		}
+
+		// Restriction à une unité organisationnelle ou à des groupes
+		if orgUnitID := d.EqualsQualString("org_unit_id"); orgUnitID != "" {
+			call.OrgUnitID(orgUnitID)
+		}
+		if groupID := d.EqualsQualString("group_id"); groupID != "" {
+			call.GroupIdFilter(adminReportsGroupIDFilter(groupID))
+		}
+
+		// 3. Filtres sur les paramètres d’événements (ex: "doc_type==document,visibility<>private")
+		if filters := d.EqualsQualString("filters"); filters != "" {
+			call.Filters(filters)
+		}
+
+		// 4. Pagination
+		const apiMaxPageSize = 1000
+		pageSize := int64(apiMaxPageSize)
+		if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+			pageSize = *d.QueryContext.Limit
+		}
+		call.MaxResults(pageSize)
+
+		for {
+			resp, err := call.Do()
+			if err != nil {
+				plugin.Logger(ctx).Error(logPrefix, "api_error", err)
+				return nil, err
+			}
+			for _, activity := range resp.Items {
+				if len(requests) > 1 && activity.Id != nil {
+					key := fmt.Sprintf("%s/%d", activity.Id.Time, activity.Id.UniqueQualifier)
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+				}
+
+				d.StreamListItem(ctx, activity)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			call.PageToken(resp.NextPageToken)
+
+			// Ajuster la taille de la page suivante selon la limite SQL restante
+			if d.QueryContext.Limit != nil {
+				if remaining := d.RowsRemaining(ctx); remaining > 0 && remaining < apiMaxPageSize {
+					call.MaxResults(remaining)
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// adminReportsGroupIDFilter construit le paramètre groupIdFilter ("id:abc") à partir du
+// qualifier group_id. Le préfixe "id:" est ajouté s'il est absent.
+func adminReportsGroupIDFilter(groupID string) string {
+	if !strings.HasPrefix(groupID, "id:") {
+		groupID = "id:" + groupID
+	}
+	return groupID
+}
+
+// adminReportsActivityQualValues retourne les valeurs demandées pour le qualifier donné
+// (valeur simple ou liste IN). Une liste contenant une chaîne vide signifie "aucun filtre".
+func adminReportsActivityQualValues(d *plugin.QueryData, column string) []string {
+	qual := d.EqualsQuals[column]
+	if qual == nil {
+		return []string{""}
+	}
+	if qual.GetListValue() != nil {
+		values := []string{}
+		for _, value := range getListValues(qual.GetListValue()) {
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) > 0 {
+			return values
+		}
+		return []string{""}
+	}
+	return []string{qual.GetStringValue()}
+}
+
+//// TRANSFORM FUNCTIONS
+
+// extractFirstEventName retourne le nom du premier événement de l'activité.
+func extractFirstEventName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	events, ok := d.Value.([]*adminreports.ActivityEvents)
+	if !ok || len(events) == 0 || events[0] == nil {
+		return "", nil
+	}
+	return events[0].Name, nil
+}
+
+// extractEventNames retourne la liste des noms d'événements de l'activité.
+func extractEventNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return nil, nil
+	}
+	if activity.Events == nil {
+		return nil, nil
+	}
+	names := []string{}
+	for _, e := range activity.Events {
+		if e.Name != "" {
+			names = append(names, e.Name)
+		}
+	}
+	return names, nil
+}
+
+func convertTimeToString(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return "", nil
+	}
+	if activity.Id == nil || activity.Id.Time == "" {
+		return "", nil
+	}
+	return activity.Id.Time, nil
+}
+
+func formatTitleWithActorEmail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	timeStr, ok := d.Value.(string)
+	if !ok {
+		return nil, nil
+	}
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return timeStr, nil
+	}
+	if activity.Actor == nil || activity.Actor.Email == "" {
+		return timeStr, nil
+	}
+	return timeStr + " - " + activity.Actor.Email, nil
+}
+
+// extractFirstEventType retourne le type du premier événement de l'activité.
+func extractFirstEventType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	events, ok := d.Value.([]*adminreports.ActivityEvents)
+	if !ok || len(events) == 0 || events[0] == nil {
+		return nil, nil
+	}
+	return events[0].Type, nil
+}
+
+// adminReportsEventParameter retourne la valeur du paramètre d'événement dont le nom est passé en d.Param.
+// Le premier événement de l'activité qui contient ce paramètre l'emporte.
+// Usage : transform.FromP(adminReportsEventParameter, "doc_id")
+func adminReportsEventParameter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	p := adminReportsFindEventParameter(d)
+	if p == nil {
+		return nil, nil
+	}
+	return adminReportsParameterValue(p), nil
+}
+
+// adminReportsEventIntParameter retourne la valeur entière (intValue) du paramètre d'événement nommé d.Param.
+// L'API omet intValue lorsqu'il vaut 0 : un paramètre présent sans valeur vaut donc 0.
+// Usage : transform.FromP(adminReportsEventIntParameter, "duration_seconds")
+func adminReportsEventIntParameter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	p := adminReportsFindEventParameter(d)
+	if p == nil {
+		return nil, nil
+	}
+	return p.IntValue, nil
+}
+
+// adminReportsEventBoolParameter retourne la valeur booléenne (boolValue) du paramètre d'événement nommé d.Param.
+// L'API omet boolValue lorsqu'il vaut false : un paramètre présent sans valeur vaut donc false.
+// Usage : transform.FromP(adminReportsEventBoolParameter, "is_external")
+func adminReportsEventBoolParameter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	p := adminReportsFindEventParameter(d)
+	if p == nil {
+		return nil, nil
+	}
+	return p.BoolValue, nil
+}
+
+// adminReportsFindEventParameter retourne le premier paramètre d'événement de l'activité
+// dont le nom est passé en d.Param, ou nil s'il est absent.
+func adminReportsFindEventParameter(d *transform.TransformData) *adminreports.ActivityEventsParameters {
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return nil
+	}
+	name := d.Param.(string)
+
+	for _, event := range activity.Events {
+		if event == nil {
+			continue
+		}
+		for _, p := range event.Parameters {
+			if p != nil && p.Name == name {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// adminReportsEventParameterList retourne les valeurs du paramètre nommé d.Param, cumulées sur tous
+// les événements de l'activité et sans doublon, sous forme de liste de chaînes.
+// Un paramètre mono-valué (value) est traité comme une liste d'un élément.
+func adminReportsEventParameterList(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return nil, nil
+	}
+	name := d.Param.(string)
+
+	values := []string{}
+	seen := map[string]bool{}
+	for _, event := range activity.Events {
+		if event == nil {
+			continue
+		}
+		for _, p := range event.Parameters {
+			if p == nil || p.Name != name {
+				continue
+			}
+			items := p.MultiValue
+			if p.Value != "" {
+				items = []string{p.Value}
+			}
+			for _, item := range items {
+				if !seen[item] {
+					seen[item] = true
+					values = append(values, item)
+				}
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
+// adminReportsParameterValue convertit un paramètre d'événement en valeur Go simple,
+// selon le champ renseigné par l'API (value, multiValue, intValue, boolValue...).
+func adminReportsParameterValue(p *adminreports.ActivityEventsParameters) interface{} {
+	switch {
+	case p.Value != "":
+		return p.Value
+	case len(p.MultiValue) > 0:
+		return p.MultiValue
+	case len(p.MultiIntValue) > 0:
+		return []int64(p.MultiIntValue)
+	case p.MessageValue != nil:
+		return adminReportsNestedParameters(p.MessageValue.Parameter)
+	case len(p.MultiMessageValue) > 0:
+		messages := []map[string]interface{}{}
+		for _, m := range p.MultiMessageValue {
+			if m != nil {
+				messages = append(messages, adminReportsNestedParameters(m.Parameter))
+			}
+		}
+		return messages
+	case p.IntValue != 0:
+		return p.IntValue
+	case p.BoolValue:
+		return true
+	}
+	// L'API omet intValue et boolValue lorsqu'ils valent 0 ou false : sans connaître
+	// le type attendu, un paramètre vide est retourné à nil. Les colonnes typées
+	// utilisent adminReportsEventIntParameter ou adminReportsEventBoolParameter.
+	return nil
+}
+
+// adminReportsNestedParameters convertit les paramètres imbriqués (messageValue) en map nom → valeur.
+func adminReportsNestedParameters(params []*adminreports.NestedParameter) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, p := range params {
+		if p == nil || p.Name == "" {
+			continue
+		}
+		switch {
+		case p.Value != "":
+			result[p.Name] = p.Value
+		case len(p.MultiValue) > 0:
+			result[p.Name] = p.MultiValue
+		case len(p.MultiIntValue) > 0:
+			result[p.Name] = []int64(p.MultiIntValue)
+		case len(p.MultiBoolValue) > 0:
+			result[p.Name] = p.MultiBoolValue
+		case p.IntValue != 0:
+			result[p.Name] = p.IntValue
+		default:
+			result[p.Name] = p.BoolValue
+		}
+	}
+	return result
+}
+
+// adminReportsEventParameters aplatit les paramètres de tous les événements de l'activité
+// en une map nom → valeur. En cas de doublon, la valeur du premier événement est conservée.
+func adminReportsEventParameters(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return nil, nil
+	}
+
+	result := map[string]interface{}{}
+	for _, event := range activity.Events {
+		if event == nil {
+			continue
+		}
+		for _, p := range event.Parameters {
+			if p == nil || p.Name == "" {
+				continue
+			}
+			if _, exists := result[p.Name]; !exists {
+				result[p.Name] = adminReportsParameterValue(p)
+			}
+		}
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
@@ -0,0 +1,170 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	adminreports "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// Format de date attendu par les rapports d'utilisation (yyyy-mm-dd)
+const adminReportsUsageDateFormat = "2006-01-02"
+
+// adminReportsUsageDates calcule la liste des dates à interroger à partir des qualifiers sur "date".
+// Sans qualifier, les rapports des 7 derniers jours (ou de la fenêtre définie par
+// admin_reports_default_lookback_days) sont retournés.
+func adminReportsUsageDates(d *plugin.QueryData) ([]string, error) {
+	lookbackDays, err := adminReportsLookbackDays(d, 7, adminReportsUsageRetentionDays)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	startDate := today.AddDate(0, 0, -lookbackDays)
+	endDate := today
+
+	if quals := d.Quals["date"]; quals != nil {
+		for _, q := range quals.Quals {
+			if q.Value == nil || q.Value.GetTimestampValue() == nil {
+				continue
+			}
+			t := q.Value.GetTimestampValue().AsTime().UTC()
+			day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+			switch q.Operator {
+			case "=":
+				startDate = day
+				endDate = day
+			case ">":
+				startDate = day.AddDate(0, 0, 1)
+			case ">=":
+				startDate = day
+			case "<":
+				// Une date strictement antérieure exclut le jour lui-même s'il est à minuit
+				if t.Equal(day) {
+					endDate = day.AddDate(0, 0, -1)
+				} else {
+					endDate = day
+				}
+			case "<=":
+				endDate = day
+			}
+		}
+	}
+
+	if !startDate.After(endDate) && startDate.Before(today.AddDate(0, 0, -adminReportsUsageRetentionDays)) {
+		return nil, fmt.Errorf("requested date %s exceeds the Reports API retention of %d days for usage reports", startDate.Format(adminReportsUsageDateFormat), adminReportsUsageRetentionDays)
+	}
+
+	dates := []string{}
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		dates = append(dates, day.Format(adminReportsUsageDateFormat))
+	}
+	return dates, nil
+}
+
+// isAdminReportsUsageDataNotAvailable indique si l'erreur correspond à une date pour laquelle
+// les rapports d'utilisation ne sont pas (encore) disponibles.
+func isAdminReportsUsageDataNotAvailable(err error) bool {
+	if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 400 {
+		return strings.Contains(gerr.Message, "not yet available") || strings.Contains(gerr.Message, "not available")
+	}
+	return false
+}
+
+//// TRANSFORM FUNCTIONS
+
+// adminReportsUsageDate convertit la date du rapport (yyyy-mm-dd) en horodatage.
+func adminReportsUsageDate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	report, ok := d.HydrateItem.(*adminreports.UsageReport)
+	if !ok || report.Date == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(adminReportsUsageDateFormat, report.Date)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// adminReportsUsageParameters convertit les paramètres du rapport en map nom → valeur.
+func adminReportsUsageParameters(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	report, ok := d.HydrateItem.(*adminreports.UsageReport)
+	if !ok {
+		return nil, nil
+	}
+
+	result := map[string]interface{}{}
+	for _, p := range report.Parameters {
+		if p != nil && p.Name != "" {
+			result[p.Name] = adminReportsUsageParameterValue(p)
+		}
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// adminReportsUsageParameter retourne la valeur du paramètre dont le nom est passé en d.Param.
+// Usage : transform.FromP(adminReportsUsageParameter, "accounts:num_users")
+func adminReportsUsageParameter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	report, ok := d.HydrateItem.(*adminreports.UsageReport)
+	if !ok {
+		return nil, nil
+	}
+	name := d.Param.(string)
+
+	for _, p := range report.Parameters {
+		if p != nil && p.Name == name {
+			return adminReportsUsageParameterValue(p), nil
+		}
+	}
+	return nil, nil
+}
+
+// adminReportsUsageIntParameter retourne la valeur entière du paramètre dont le nom est passé en d.Param.
+// L'API omet intValue lorsqu'il vaut 0 : un paramètre présent sans valeur est donc retourné comme 0.
+func adminReportsUsageIntParameter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	report, ok := d.HydrateItem.(*adminreports.UsageReport)
+	if !ok {
+		return nil, nil
+	}
+	name := d.Param.(string)
+
+	for _, p := range report.Parameters {
+		if p != nil && p.Name == name {
+			return p.IntValue, nil
+		}
+	}
+	return nil, nil
+}
+
+// adminReportsUsageParameterValue retourne la valeur renseignée par l'API pour un paramètre d'utilisation.
+func adminReportsUsageParameterValue(p *adminreports.UsageReportParameters) interface{} {
+	switch {
+	case p.StringValue != "":
+		return p.StringValue
+	case p.DatetimeValue != "":
+		return p.DatetimeValue
+	case len(p.MsgValue) > 0:
+		messages := []interface{}{}
+		for _, raw := range p.MsgValue {
+			var message interface{}
+			if err := json.Unmarshal(raw, &message); err == nil {
+				messages = append(messages, message)
+			}
+		}
+		return messages
+	case p.IntValue != 0:
+		return p.IntValue
+	}
+	// Comme pour les événements d'activité, boolValue est omis lorsqu'il vaut false
+	return p.BoolValue
+}
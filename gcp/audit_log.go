@@ -0,0 +1,382 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/logging/v2"
+)
+
+// auditLogRow is a Cloud Audit Logs entry along with its decoded AuditLog payload
+type auditLogRow struct {
+	Entry    *logging.LogEntry
+	AuditLog *auditLogPayload
+}
+
+// auditLogPayload is the subset of the google.cloud.audit.AuditLog message
+// exposed by the audit log tables
+// https://cloud.google.com/logging/docs/reference/audit/auditlog/rest/Shared.Types/AuditLog
+type auditLogPayload struct {
+	ServiceName        string                   `json:"serviceName"`
+	MethodName         string                   `json:"methodName"`
+	ResourceName       string                   `json:"resourceName"`
+	NumResponseItems   string                   `json:"numResponseItems"`
+	Status             *auditLogStatus          `json:"status"`
+	AuthenticationInfo *auditLogAuthentication  `json:"authenticationInfo"`
+	AuthorizationInfo  []interface{}            `json:"authorizationInfo"`
+	RequestMetadata    *auditLogRequestMetadata `json:"requestMetadata"`
+	ResourceLocation   interface{}              `json:"resourceLocation"`
+	Request            map[string]interface{}   `json:"request"`
+	Response           map[string]interface{}   `json:"response"`
+	Metadata           map[string]interface{}   `json:"metadata"`
+	ServiceData        map[string]interface{}   `json:"serviceData"`
+}
+
+type auditLogStatus struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+type auditLogAuthentication struct {
+	PrincipalEmail               string        `json:"principalEmail"`
+	PrincipalSubject             string        `json:"principalSubject"`
+	ServiceAccountKeyName        string        `json:"serviceAccountKeyName"`
+	ServiceAccountDelegationInfo []interface{} `json:"serviceAccountDelegationInfo"`
+}
+
+type auditLogRequestMetadata struct {
+	CallerIp                string `json:"callerIp"`
+	CallerSuppliedUserAgent string `json:"callerSuppliedUserAgent"`
+	CallerNetwork           string `json:"callerNetwork"`
+}
+
+//// TABLE DEFINITION
+
+// auditLogKeyColumns returns the qualifiers shared by the audit log tables,
+// all of which are passed to the Logging API as a filter
+func auditLogKeyColumns() plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<="}},
+		{Name: "service_name", Require: plugin.Optional},
+		{Name: "method_name", Require: plugin.Optional},
+		{Name: "resource_name", Require: plugin.Optional},
+		{Name: "resource_type", Require: plugin.Optional},
+		{Name: "principal_email", Require: plugin.Optional},
+		{Name: "caller_ip", Require: plugin.Optional},
+		{Name: "severity", Require: plugin.Optional},
+		{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+	}
+}
+
+// auditLogColumns appends the columns specific to a table to the columns
+// shared by the audit log tables
+func auditLogColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(commonAuditLogColumns(), columns...)
+}
+
+func commonAuditLogColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "timestamp",
+			Description: "The time the audited operation occurred.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Entry.Timestamp"),
+		},
+		{
+			Name:        "insert_id",
+			Description: "A unique identifier for the log entry.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entry.InsertId"),
+		},
+		{
+			Name:        "service_name",
+			Description: "The name of the API service performing the operation, for example compute.googleapis.com.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.ServiceName"),
+		},
+		{
+			Name:        "method_name",
+			Description: "The name of the service method or operation, for example v1.compute.instances.insert.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.MethodName"),
+		},
+		{
+			Name:        "resource_name",
+			Description: "The resource or collection that is the target of the operation.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.ResourceName"),
+		},
+		{
+			Name:        "resource_type",
+			Description: "The type of the monitored resource that produced the log entry, for example gce_instance.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entry.Resource.Type"),
+		},
+		{
+			Name:        "principal_email",
+			Description: "The email address of the authenticated user, or service account, making the request.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.AuthenticationInfo.PrincipalEmail"),
+		},
+		{
+			Name:        "principal_subject",
+			Description: "String representation of the identity of the requesting party, for example a workforce or workload identity federation principal.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.AuthenticationInfo.PrincipalSubject"),
+		},
+		{
+			Name:        "service_account_key_name",
+			Description: "The name of the service account key used to create or exchange credentials for authenticating the service account making the request.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.AuthenticationInfo.ServiceAccountKeyName"),
+		},
+		{
+			Name:        "caller_ip",
+			Description: "The IP address of the caller.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.RequestMetadata.CallerIp"),
+		},
+		{
+			Name:        "caller_supplied_user_agent",
+			Description: "The user agent of the caller.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.RequestMetadata.CallerSuppliedUserAgent"),
+		},
+		{
+			Name:        "status_code",
+			Description: "The status code of the operation. 0 means that the operation succeeded.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("AuditLog.Status.Code"),
+		},
+		{
+			Name:        "status_message",
+			Description: "The error message of the operation, if it failed.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("AuditLog.Status.Message"),
+		},
+		{
+			Name:        "severity",
+			Description: "The severity of the log entry.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entry.Severity"),
+		},
+		{
+			Name:        "log_name",
+			Description: "The resource name of the log to which the log entry belongs.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entry.LogName"),
+		},
+		{
+			Name:        "receive_timestamp",
+			Description: "The time the log entry was received by Logging.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Entry.ReceiveTimestamp"),
+		},
+		{
+			Name:        "filter",
+			Description: "An additional Logging query language filter applied to the audit log entries.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("filter"),
+		},
+		{
+			Name:        "authentication_info",
+			Description: "Authentication information of the caller, including the service account delegation chain.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.AuthenticationInfo"),
+		},
+		{
+			Name:        "authorization_info",
+			Description: "Authorization information. There is one entry per permission checked for the operation.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.AuthorizationInfo"),
+		},
+		{
+			Name:        "request_metadata",
+			Description: "Metadata about the request, such as the caller IP address, user agent and network.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.RequestMetadata"),
+		},
+		{
+			Name:        "request",
+			Description: "The operation request, if it is considered safe to be logged.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.Request"),
+		},
+		{
+			Name:        "response",
+			Description: "The operation response, if it is considered safe to be logged.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.Response"),
+		},
+		{
+			Name:        "resource_location",
+			Description: "The locations of the resource that is the target of the operation.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.ResourceLocation"),
+		},
+		{
+			Name:        "metadata",
+			Description: "Service-specific data about the operation.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("AuditLog.Metadata"),
+		},
+		{
+			Name:        "resource",
+			Description: "The monitored resource that produced the log entry.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("Entry.Resource"),
+		},
+		{
+			Name:        "operation",
+			Description: "Information about a long-running operation associated with the log entry, if applicable.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("Entry.Operation"),
+		},
+		{
+			Name:        "labels",
+			Description: "A map of key, value pairs that provides additional information about the log entry.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("Entry.Labels"),
+		},
+
+		// Standard steampipe columns
+		{
+			Name:        "title",
+			Description: ColumnDescriptionTitle,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entry.InsertId"),
+		},
+
+		// Standard GCP columns
+		{
+			Name:        "location",
+			Description: ColumnDescriptionLocation,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromConstant("global"),
+		},
+		{
+			Name:        "project",
+			Description: ColumnDescriptionProject,
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     getProject,
+			Transform:   transform.FromValue(),
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// listAuditLogEntries lists the entries of the given Cloud Audit Logs log of
+// the project, for example activity or data_access
+func listAuditLogEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, logId string) (interface{}, error) {
+	logPrefix := "gcp_audit_log.listAuditLogEntries"
+
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(logPrefix, "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000, as audit log entries are much larger than most log entries
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	param := &logging.ListLogEntriesRequest{
+		PageSize:      *pageSize,
+		ResourceNames: []string{"projects/" + project},
+		Filter:        buildAuditLogFilter(d.Quals, "projects/"+project+"/logs/cloudaudit.googleapis.com%2F"+logId),
+		OrderBy:       "timestamp desc",
+	}
+
+	if err := service.Entries.List(param).Pages(ctx, func(page *logging.ListLogEntriesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, entry := range page.Entries {
+			payload := &auditLogPayload{}
+			if len(entry.ProtoPayload) > 0 {
+				if err := json.Unmarshal(entry.ProtoPayload, payload); err != nil {
+					plugin.Logger(ctx).Error(logPrefix, "unmarshal_error", err)
+					return err
+				}
+			}
+			d.StreamListItem(ctx, &auditLogRow{Entry: entry, AuditLog: payload})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error(logPrefix, "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// https://cloud.google.com/logging/docs/view/logging-query-language
+func buildAuditLogFilter(quals plugin.KeyColumnQualMap, logName string) string {
+	filterQuals := []filterQualMap{
+		{"service_name", "protoPayload.serviceName", "string"},
+		{"method_name", "protoPayload.methodName", "string"},
+		{"resource_name", "protoPayload.resourceName", "string"},
+		{"resource_type", "resource.type", "string"},
+		{"principal_email", "protoPayload.authenticationInfo.principalEmail", "string"},
+		{"caller_ip", "protoPayload.requestMetadata.callerIp", "string"},
+		{"severity", "severity", "string"},
+		{"timestamp", "timestamp", "timestamp"},
+	}
+
+	filters := []string{fmt.Sprintf("logName=\"%s\"", logName)}
+	filters = append(filters, buildQueryFilterFromQuals(filterQuals, quals)...)
+
+	// The user supplied filter is combined with the filters built from the other qualifiers
+	if filter := quals["filter"]; filter != nil {
+		for _, qual := range filter.Quals {
+			if qual.Value != nil && qual.Value.GetStringValue() != "" {
+				filters = append(filters, "("+qual.Value.GetStringValue()+")")
+			}
+		}
+	}
+
+	return strings.Join(filters, " AND ")
+}
+
+//// TRANSFORM FUNCTIONS
+
+// auditLogMetadataField returns the field of the service-specific metadata of
+// the audit log whose name is passed in d.Param
+func auditLogMetadataField(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(*auditLogRow)
+	if row.AuditLog == nil || row.AuditLog.Metadata == nil {
+		return nil, nil
+	}
+	return row.AuditLog.Metadata[d.Param.(string)], nil
+}
@@ -0,0 +1,29 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// BuildBigQueryReservationLocationList :: return a list of matrix items, one per BigQuery location
+// The Reservation API has no method to list its locations, so the compute
+// regions are used along with the US and EU multi-regions.
+func BuildBigQueryReservationLocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// have we already created and cached the locations?
+	locationCacheKey := "BigQueryReservation"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(locationCacheKey); ok {
+		plugin.Logger(ctx).Trace("listlocationDetails:", cachedData.([]map[string]interface{}))
+		return cachedData.([]map[string]interface{})
+	}
+
+	matrix := []map[string]interface{}{
+		{matrixKeyLocation: "US"},
+		{matrixKeyLocation: "EU"},
+	}
+	matrix = append(matrix, BuildComputeLocationList(ctx, d)...)
+
+	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
+	return matrix
+}
@@ -10,9 +10,16 @@ type gcpConfig struct {
 	ImpersonateAccessToken    *string  `hcl:"impersonate_access_token"`
 	ImpersonateServiceAccount *string  `hcl:"impersonate_service_account"`
 	ImpersonateUserEmail      *string  `hcl:"impersonate_user_email"`
-  	QuotaProject              *string  `hcl:"quota_project,optional"`
+	QuotaProject              *string  `hcl:"quota_project,optional"`
 	IgnoreErrorMessages       []string `hcl:"ignore_error_messages,optional"`
 	IgnoreErrorCodes          []string `hcl:"ignore_error_codes,optional"`
+
+	AdminScopes                     []string `hcl:"admin_scopes,optional"`
+	AdminReportsDefaultLookbackDays *int     `hcl:"admin_reports_default_lookback_days,optional"`
+
+	RedactEnvironmentVariables *bool `hcl:"redact_environment_variables,optional"`
+
+	BillingExportDataset *string `hcl:"billing_export_dataset,optional"`
 }
 
 func ConfigInstance() interface{} {
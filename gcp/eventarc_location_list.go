@@ -0,0 +1,50 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/eventarc/v1"
+)
+
+// BuildEventarcLocationList :: return a list of matrix items, one per location
+func BuildEventarcLocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// have we already created and cached the locations?
+	locationCacheKey := "BuildEventarcLocationList"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(locationCacheKey); ok {
+		plugin.Logger(ctx).Debug("BuildEventarcLocationList:", cachedData.([]map[string]interface{}))
+		return cachedData.([]map[string]interface{})
+	}
+
+	// Create Service Connection
+	service, err := EventarcService(ctx, d)
+	if err != nil {
+		return nil
+	}
+
+	// Get project details
+	projectData, err := activeProject(ctx, d)
+	if err != nil {
+		return nil
+	}
+	project := projectData.Project
+
+	resp := service.Projects.Locations.List("projects/" + project)
+
+	var locations []*eventarc.Location
+
+	if err := resp.Pages(ctx, func(page *eventarc.ListLocationsResponse) error {
+		locations = append(locations, page.Locations...)
+		return nil
+	}); err != nil {
+		return nil
+	}
+
+	matrix := make([]map[string]interface{}, len(locations))
+	for i, location := range locations {
+		matrix[i] = map[string]interface{}{matrixKeyLocation: location.LocationId}
+	}
+	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
+	return matrix
+}
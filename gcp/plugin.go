@@ -72,26 +72,80 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			NewInstance: ConfigInstance,
 		},
 		TableMap: map[string]*plugin.Table{
+			"gcp_access_context_manager_access_level":                 tableGcpAccessContextManagerAccessLevel(ctx),
+			"gcp_access_context_manager_access_policy":                tableGcpAccessContextManagerAccessPolicy(ctx),
+			"gcp_access_context_manager_service_perimeter":            tableGcpAccessContextManagerServicePerimeter(ctx),
+			"gcp_admin_directory_asp":                                 tableGcpAdminDirectoryAsp(ctx),
+			"gcp_admin_directory_chromeos_device":                     tableGcpAdminDirectoryChromeosDevice(ctx),
+			"gcp_admin_directory_domain":                              tableGcpAdminDirectoryDomain(ctx),
+			"gcp_admin_directory_domain_alias":                        tableGcpAdminDirectoryDomainAlias(ctx),
+			"gcp_admin_directory_group":                               tableGcpAdminDirectoryGroup(ctx),
+			"gcp_admin_directory_group_member":                        tableGcpAdminDirectoryGroupMember(ctx),
+			"gcp_admin_directory_mobile_device":                       tableGcpAdminDirectoryMobileDevice(ctx),
+			"gcp_admin_directory_role":                                tableGcpAdminDirectoryRole(ctx),
+			"gcp_admin_directory_role_assignment":                     tableGcpAdminDirectoryRoleAssignment(ctx),
+			"gcp_admin_directory_token":                               tableGcpAdminDirectoryToken(ctx),
+			"gcp_admin_reports_access_transparency_activity":          tableGcpAdminReportsAccessTransparencyActivity(ctx),
 			"gcp_admin_reports_admin_activity":						   tableGcpAdminReportsAdminActivity(ctx),
+			"gcp_admin_reports_calendar_activity":                     tableGcpAdminReportsCalendarActivity(ctx),
+			"gcp_admin_reports_chat_activity":                         tableGcpAdminReportsChatActivity(ctx),
+			"gcp_admin_reports_chrome_activity":                       tableGcpAdminReportsChromeActivity(ctx),
+			"gcp_admin_reports_customer_usage":                        tableGcpAdminReportsCustomerUsage(ctx),
+			"gcp_admin_reports_data_studio_activity":                  tableGcpAdminReportsDataStudioActivity(ctx),
+			"gcp_admin_reports_entity_usage":                          tableGcpAdminReportsEntityUsage(ctx),
+			"gcp_admin_reports_meet_activity":                         tableGcpAdminReportsMeetActivity(ctx),
 			"gcp_admin_reports_mobile_activity":					   tableGcpAdminReportsMobileActivity(ctx),
+			"gcp_admin_reports_rules_activity":                        tableGcpAdminReportsRulesActivity(ctx),
 			"gcp_admin_reports_token_activity":						   tableGcpAdminReportsTokenActivity(ctx),
 			"gcp_admin_reports_drive_activity":						   tableGcpAdminReportsDriveActivity(ctx),
 			"gcp_admin_reports_login_activity":						   tableGcpAdminReportsLoginActivity(ctx),
+			"gcp_admin_reports_user_accounts_activity":                tableGcpAdminReportsUserAccountsActivity(ctx),
+			"gcp_admin_reports_user_usage":                            tableGcpAdminReportsUserUsage(ctx),
 			"gcp_alloydb_cluster":                                     tableGcpAlloyDBCluster(ctx),
 			"gcp_alloydb_instance":                                    tableGcpAlloyDBInstance(ctx),
 			"gcp_apikeys_key":                                         tableGcpApiKeysKey(ctx),
 			"gcp_app_engine_application":                              tableGcpAppEngineApplication(ctx),
+			"gcp_app_engine_service":                                  tableGcpAppEngineService(ctx),
+			"gcp_app_engine_version":                                  tableGcpAppEngineVersion(ctx),
+			"gcp_artifact_registry_package":                           tableGcpArtifactRegistryPackage(ctx),
 			"gcp_artifact_registry_repository":                        tableGcpArtifactRegistryRepository(ctx),
+			"gcp_artifact_registry_tag":                               tableGcpArtifactRegistryTag(ctx),
+			"gcp_artifact_registry_version":                           tableGcpArtifactRegistryVersion(ctx),
+			"gcp_audit_log_admin_activity":                            tableGcpAuditLogAdminActivity(ctx),
+			"gcp_audit_log_data_access":                               tableGcpAuditLogDataAccess(ctx),
+			"gcp_audit_log_policy_denied":                             tableGcpAuditLogPolicyDenied(ctx),
+			"gcp_audit_log_system_event":                              tableGcpAuditLogSystemEvent(ctx),
 			"gcp_audit_policy":                                        tableGcpAuditPolicy(ctx),
+			"gcp_bigquery_capacity_commitment":                        tableGcpBigQueryCapacityCommitment(ctx),
 			"gcp_bigquery_dataset":                                    tableGcpBigQueryDataset(ctx),
 			"gcp_bigquery_job":                                        tableGcpBigQueryJob(ctx),
+			"gcp_bigquery_reservation":                                tableGcpBigQueryReservation(ctx),
+			"gcp_bigquery_reservation_assignment":                     tableGcpBigQueryReservationAssignment(ctx),
 			"gcp_bigquery_table":                                      tableGcpBigqueryTable(ctx),
+			"gcp_bigtable_app_profile":                                tableGcpBigtableAppProfile(ctx),
+			"gcp_bigtable_cluster":                                    tableGcpBigtableCluster(ctx),
 			"gcp_bigtable_instance":                                   tableGcpBigtableInstance(ctx),
+			"gcp_bigtable_table":                                      tableGcpBigtableTable(ctx),
 			"gcp_billing_account":                                     tableGcpBillingAccount(ctx),
 			"gcp_billing_budget":                                      tableGcpBillingBudget(ctx),
+			"gcp_billing_export":                                      tableGcpBillingExport(ctx),
+			"gcp_billing_info":                                        tableGcpBillingInfo(ctx),
+			"gcp_certificate_manager_certificate":                     tableGcpCertificateManagerCertificate(ctx),
+			"gcp_certificate_manager_certificate_map":                 tableGcpCertificateManagerCertificateMap(ctx),
+			"gcp_certificate_manager_certificate_map_entry":           tableGcpCertificateManagerCertificateMapEntry(ctx),
 			"gcp_cloud_asset":                                         tableGcpCloudAsset(ctx),
+			"gcp_cloud_build_build":                                   tableGcpCloudBuildBuild(ctx),
+			"gcp_cloud_build_trigger":                                 tableGcpCloudBuildTrigger(ctx),
+			"gcp_cloud_deploy_delivery_pipeline":                      tableGcpCloudDeployDeliveryPipeline(ctx),
+			"gcp_cloud_deploy_release":                                tableGcpCloudDeployRelease(ctx),
+			"gcp_cloud_deploy_rollout":                                tableGcpCloudDeployRollout(ctx),
+			"gcp_cloud_deploy_target":                                 tableGcpCloudDeployTarget(ctx),
 			"gcp_cloud_identity_group":                                tableGcpCloudIdentityGroup(ctx),
 			"gcp_cloud_identity_group_membership":                     tableGcpCloudIdentityGroupMembership(ctx),
+			"gcp_cloud_profiler_profile":                              tableGcpCloudProfilerProfile(ctx),
+			"gcp_cloud_scheduler_job":                                 tableGcpCloudSchedulerJob(ctx),
+			"gcp_cloud_tasks_queue":                                   tableGcpCloudTasksQueue(ctx),
+			"gcp_cloud_trace":                                         tableGcpCloudTrace(ctx),
 			"gcp_cloudfunctions_function":                             tableGcpCloudfunctionFunction(ctx),
 			"gcp_cloud_run_job":                                       tableGcpCloudRunJob(ctx),
 			"gcp_cloud_run_service":                                   tableGcpCloudRunService(ctx),
@@ -100,6 +154,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_compute_autoscaler":                                  tableGcpComputeAutoscaler(ctx),
 			"gcp_compute_backend_bucket":                              tableGcpComputeBackendBucket(ctx),
 			"gcp_compute_backend_service":                             tableGcpComputeBackendService(ctx),
+			"gcp_compute_commitment":                                  tableGcpComputeCommitment(ctx),
 			"gcp_compute_disk":                                        tableGcpComputeDisk(ctx),
 			"gcp_compute_disk_metric_read_ops":                        tableGcpComputeDiskMetricReadOps(ctx),
 			"gcp_compute_disk_metric_read_ops_daily":                  tableGcpComputeDiskMetricReadOpsDaily(ctx),
@@ -108,6 +163,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_compute_disk_metric_write_ops_daily":                 tableGcpComputeDiskMetricWriteOpsDaily(ctx),
 			"gcp_compute_disk_metric_write_ops_hourly":                tableGcpComputeDiskMetricWriteOpsHourly(ctx),
 			"gcp_compute_firewall":                                    tableGcpComputeFirewall(ctx),
+			"gcp_compute_firewall_policy":                             tableGcpComputeFirewallPolicy(ctx),
+			"gcp_compute_firewall_policy_rule":                        tableGcpComputeFirewallPolicyRule(ctx),
 			"gcp_compute_forwarding_rule":                             tableGcpComputeForwardingRule(ctx),
 			"gcp_compute_global_address":                              tableGcpComputeGlobalAddress(ctx),
 			"gcp_compute_global_forwarding_rule":                      tableGcpComputeGlobalForwardingRule(ctx),
@@ -120,38 +177,68 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_compute_instance_metric_cpu_utilization_daily":       tableGcpComputeInstanceMetricCpuUtilizationDaily(ctx),
 			"gcp_compute_instance_metric_cpu_utilization_hourly":      tableGcpComputeInstanceMetricCpuUtilizationHourly(ctx),
 			"gcp_compute_instance_template":                           tableGcpComputeInstanceTemplate(ctx),
+			"gcp_compute_interconnect":                                tableGcpComputeInterconnect(ctx),
+			"gcp_compute_interconnect_attachment":                     tableGcpComputeInterconnectAttachment(ctx),
 			"gcp_compute_machine_image":                               tableGcpComputeMachineImage(ctx),
 			"gcp_compute_machine_type":                                tableGcpComputeMachineType(ctx),
 			"gcp_compute_network":                                     tableGcpComputeNetwork(ctx),
+			"gcp_compute_network_endpoint_group":                      tableGcpComputeNetworkEndpointGroup(ctx),
 			"gcp_compute_node_group":                                  tableGcpComputeNodeGroup(ctx),
 			"gcp_compute_node_template":                               tableGcpComputeNodeTemplate(ctx),
 			"gcp_compute_project_metadata":                            tableGcpComputeProjectMetadata(ctx),
 			"gcp_compute_region":                                      tableGcpComputeRegion(ctx),
+			"gcp_compute_reservation":                                 tableGcpComputeReservation(ctx),
 			"gcp_compute_resource_policy":                             tableGcpComputeResourcePolicy(ctx),
 			"gcp_compute_router":                                      tableGcpComputeRouter(ctx),
+			"gcp_compute_security_policy":                             tableGcpComputeSecurityPolicy(ctx),
+			"gcp_compute_security_policy_rule":                        tableGcpComputeSecurityPolicyRule(ctx),
+			"gcp_compute_service_attachment":                          tableGcpComputeServiceAttachment(ctx),
 			"gcp_compute_snapshot":                                    tableGcpComputeSnapshot(ctx),
+			"gcp_compute_ssl_certificate":                             tableGcpComputeSslCertificate(ctx),
 			"gcp_compute_ssl_policy":                                  tableGcpComputeSslPolicy(ctx),
 			"gcp_compute_subnetwork":                                  tableGcpComputeSubnetwork(ctx),
+			"gcp_compute_target_http_proxy":                           tableGcpComputeTargetHttpProxy(ctx),
 			"gcp_compute_target_https_proxy":                          tableGcpComputeTargetHttpsProxy(ctx),
 			"gcp_compute_target_pool":                                 tableGcpComputeTargetPool(ctx),
 			"gcp_compute_target_ssl_proxy":                            tableGcpComputeTargetSslProxy(ctx),
+			"gcp_compute_target_tcp_proxy":                            tableGcpComputeTargetTcpProxy(ctx),
 			"gcp_compute_target_vpn_gateway":                          tableGcpComputeTargetVpnGateway(ctx),
 			"gcp_compute_tpu":                                         tableGcpComputeTpu(ctx),
 			"gcp_compute_url_map":                                     tableGcpComputeURLMap(ctx),
 			"gcp_compute_vpn_tunnel":                                  tableGcpComputeVpnTunnel(ctx),
 			"gcp_compute_zone":                                        tableGcpComputeZone(ctx),
+			"gcp_container_analysis_note":                             tableGcpContainerAnalysisNote(ctx),
+			"gcp_container_analysis_occurrence":                       tableGcpContainerAnalysisOccurrence(ctx),
+			"gcp_datafusion_instance":                                 tableGcpDataFusionInstance(ctx),
 			"gcp_dataplex_asset":                                      tableGcpDataplexAsset(ctx),
 			"gcp_dataplex_lake":                                       tableGcpDataplexLake(ctx),
 			"gcp_dataplex_task":                                       tableGcpDataplexTask(ctx),
 			"gcp_dataplex_zone":                                       tableGcpDataplexZone(ctx),
 			"gcp_dataproc_cluster":                                    tableGcpDataprocCluster(ctx),
+			"gcp_dataproc_job":                                        tableGcpDataprocJob(ctx),
 			"gcp_dataproc_metastore_service":                          tableGcpDataprocMetastoreService(ctx),
 			"gcp_dns_managed_zone":                                    tableGcpDnsManagedZone(ctx),
 			"gcp_dns_policy":                                          tableDnsPolicy(ctx),
 			"gcp_dns_record_set":                                      tableDnsRecordSet(ctx),
+			"gcp_dns_response_policy":                                 tableDnsResponsePolicy(ctx),
+			"gcp_dns_response_policy_rule":                            tableDnsResponsePolicyRule(ctx),
+			"gcp_essential_contact":                                   tableGcpEssentialContact(ctx),
+			"gcp_eventarc_channel":                                    tableGcpEventarcChannel(ctx),
+			"gcp_eventarc_trigger":                                    tableGcpEventarcTrigger(ctx),
+			"gcp_filestore_backup":                                    tableGcpFilestoreBackup(ctx),
+			"gcp_filestore_instance":                                  tableGcpFilestoreInstance(ctx),
 			"gcp_firestore_database":                                  tableGcpFirestoreDatabase(ctx),
+			"gcp_firestore_index":                                     tableGcpFirestoreIndex(ctx),
+			"gcp_folder":                                              tableGcpFolder(ctx),
+			"gcp_iam_custom_role":                                     tableGcpIamCustomRole(ctx),
 			"gcp_iam_policy":                                          tableGcpIAMPolicy(ctx),
+			"gcp_iam_policy_binding":                                  tableGcpIAMPolicyBinding(ctx),
 			"gcp_iam_role":                                            tableGcpIamRole(ctx),
+			"gcp_iam_workload_identity_pool":                          tableGcpIamWorkloadIdentityPool(ctx),
+			"gcp_iam_workload_identity_pool_provider":                 tableGcpIamWorkloadIdentityPoolProvider(ctx),
+			"gcp_iap_settings":                                        tableGcpIapSettings(ctx),
+			"gcp_identity_platform_config":                            tableGcpIdentityPlatformConfig(ctx),
+			"gcp_identity_platform_tenant":                            tableGcpIdentityPlatformTenant(ctx),
 			"gcp_kms_key":                                             tableGcpKmsKey(ctx),
 			"gcp_kms_key_ring":                                        tableGcpKmsKeyRing(ctx),
 			"gcp_kms_key_version":                                     tableGcpKmsKeyVersion(ctx),
@@ -162,22 +249,50 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_logging_log_entry":                                   tableGcpLoggingLogEntry(ctx),
 			"gcp_logging_metric":                                      tableGcpLoggingMetric(ctx),
 			"gcp_logging_sink":                                        tableGcpLoggingSink(ctx),
+			"gcp_memcache_instance":                                   tableGcpMemcacheInstance(ctx),
 			"gcp_monitoring_alert_policy":                             tableGcpMonitoringAlert(ctx),
+			"gcp_monitoring_alert_policy_condition":                   tableGcpMonitoringAlertPolicyCondition(ctx),
+			"gcp_monitoring_dashboard":                                tableGcpMonitoringDashboard(ctx),
 			"gcp_monitoring_group":                                    tableGcpMonitoringGroup(ctx),
+			"gcp_monitoring_metric":                                   tableGcpMonitoringMetric(ctx),
 			"gcp_monitoring_notification_channel":                     tableGcpMonitoringNotificationChannel(ctx),
+			"gcp_monitoring_uptime_check":                             tableGcpMonitoringUptimeCheck(ctx),
+			"gcp_network_connectivity_hub":                            tableGcpNetworkConnectivityHub(ctx),
+			"gcp_network_connectivity_spoke":                          tableGcpNetworkConnectivitySpoke(ctx),
+			"gcp_notebooks_instance":                                  tableGcpNotebooksInstance(ctx),
+			"gcp_notebooks_runtime":                                   tableGcpNotebooksRuntime(ctx),
 			"gcp_organization":                                        tableGcpOrganization(ctx),
 			"gcp_organization_project":                                tableGcpOrganizationProject(ctx),
 			"gcp_project":                                             tableGcpProject(ctx),
 			"gcp_project_organization_policy":                         tableGcpProjectOrganizationPolicy(ctx),
 			"gcp_project_service":                                     tableGcpProjectService(ctx),
+			"gcp_pubsub_schema":                                       tableGcpPubSubSchema(ctx),
 			"gcp_pubsub_snapshot":                                     tableGcpPubSubSnapshot(ctx),
 			"gcp_pubsub_subscription":                                 tableGcpPubSubSubscription(ctx),
 			"gcp_pubsub_topic":                                        tableGcpPubSubTopic(ctx),
+			"gcp_recommender_insight":                                 tableGcpRecommenderInsight(ctx),
+			"gcp_recommender_recommendation":                          tableGcpRecommenderRecommendation(ctx),
 			"gcp_redis_cluster":                                       tableGcpRedisCluster(ctx),
 			"gcp_redis_instance":                                      tableGcpRedisInstance(ctx),
+			"gcp_resource_manager_lien":                               tableGcpResourceManagerLien(ctx),
+			"gcp_resource_manager_org_policy":                         tableGcpResourceManagerOrgPolicy(ctx),
 			"gcp_secret_manager_secret":                               tableGcpSecretManagerSecret(ctx),
+			"gcp_secret_manager_secret_version":                       tableGcpSecretManagerSecretVersion(ctx),
+			"gcp_security_command_center_asset":                       tableGcpSecurityCommandCenterAsset(ctx),
+			"gcp_security_command_center_finding":                     tableGcpSecurityCommandCenterFinding(ctx),
+			"gcp_security_command_center_notification_config":         tableGcpSecurityCommandCenterNotificationConfig(ctx),
+			"gcp_security_command_center_source":                      tableGcpSecurityCommandCenterSource(ctx),
 			"gcp_service_account":                                     tableGcpServiceAccount(ctx),
 			"gcp_service_account_key":                                 tableGcpServiceAccountKey(ctx),
+			"gcp_service_directory_endpoint":                          tableGcpServiceDirectoryEndpoint(ctx),
+			"gcp_service_directory_namespace":                         tableGcpServiceDirectoryNamespace(ctx),
+			"gcp_service_directory_service":                           tableGcpServiceDirectoryService(ctx),
+			"gcp_service_usage_consumer_quota_override":               tableGcpServiceUsageConsumerQuotaOverride(ctx),
+			"gcp_service_usage_quota":                                 tableGcpServiceUsageQuota(ctx),
+			"gcp_source_repositories_repo":                            tableGcpSourceRepositoriesRepo(ctx),
+			"gcp_spanner_backup":                                      tableGcpSpannerBackup(ctx),
+			"gcp_spanner_database":                                    tableGcpSpannerDatabase(ctx),
+			"gcp_spanner_instance":                                    tableGcpSpannerInstance(ctx),
 			"gcp_sql_backup":                                          tableGcpSQLBackup(ctx),
 			"gcp_sql_database":                                        tableGcpSQLDatabase(ctx),
 			"gcp_sql_database_instance":                               tableGcpSQLDatabaseInstance(ctx),
@@ -190,11 +305,22 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_storage_bucket":                                      tableGcpStorageBucket(ctx),
 			"gcp_storage_object":                                      tableGcpStorageObject(ctx),
 			"gcp_tag_binding":                                         tableGcpTagBinding(ctx),
+			"gcp_tag_key":                                             tableGcpTagKey(ctx),
+			"gcp_tag_value":                                           tableGcpTagValue(ctx),
 			"gcp_tpu_vm":                                              tableGcpTpuVM(ctx),
+			"gcp_vertex_ai_custom_job":                                tableGcpVertexAICustomJob(ctx),
+			"gcp_vertex_ai_dataset":                                   tableGcpVertexAIDataset(ctx),
 			"gcp_vertex_ai_endpoint":                                  tableGcpVertexAIEndpoint(ctx),
+			"gcp_vertex_ai_feature_store":                             tableGcpVertexAIFeatureStore(ctx),
+			"gcp_vertex_ai_feature_store_entity_type":                 tableGcpVertexAIFeatureStoreEntityType(ctx),
+			"gcp_vertex_ai_feature_store_feature":                     tableGcpVertexAIFeatureStoreFeature(ctx),
 			"gcp_vertex_ai_notebook_runtime_template":                 tableGcpVertexAINotebookRuntimeTemplate(ctx),
 			"gcp_vertex_ai_model":                                     tableGcpVertexAIModel(ctx),
+			"gcp_vertex_ai_pipeline_job":                              tableGcpVertexAIPipelineJob(ctx),
 			"gcp_vpc_access_connector":                                tableGcpVPCAccessConnector(ctx),
+			"gcp_workflows_execution":                                 tableGcpWorkflowsExecution(ctx),
+			"gcp_workflows_workflow":                                  tableGcpWorkflowsWorkflow(ctx),
+			"gcp_workspace_alert":                                     tableGcpWorkspaceAlert(ctx),
 			/*
 				https://github.com/turbot/steampipe/issues/108
 				"gcp_compute_route":                   tableGcpComputeRoute(ctx),
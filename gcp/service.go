@@ -3,54 +3,83 @@ package gcp
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
+	"net/http"
 	"os"
-	"path/filepath"
-	"strings"
 
 	aiplatform "cloud.google.com/go/aiplatform/apiv1"
 	redis "cloud.google.com/go/redis/apiv1"
 	rediscluster "cloud.google.com/go/redis/cluster/apiv1"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"golang.org/x/oauth2/google"
 	"google.golang.org/api/accessapproval/v1"
+	"google.golang.org/api/accesscontextmanager/v1"
+	admin "google.golang.org/api/admin/directory/v1"
+	adminreports "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/alertcenter/v1beta1"
 	"google.golang.org/api/alloydb/v1"
 	"google.golang.org/api/apikeys/v2"
 	"google.golang.org/api/appengine/v1"
 	"google.golang.org/api/artifactregistry/v1"
 	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/bigqueryreservation/v1"
 	"google.golang.org/api/bigtableadmin/v2"
 	"google.golang.org/api/billingbudgets/v1"
+	"google.golang.org/api/certificatemanager/v1"
 	"google.golang.org/api/cloudasset/v1"
 	"google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/cloudbuild/v1"
+	"google.golang.org/api/clouddeploy/v1"
 	"google.golang.org/api/cloudfunctions/v2"
 	"google.golang.org/api/cloudidentity/v1"
 	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/cloudprofiler/v2"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/cloudscheduler/v1"
+	"google.golang.org/api/cloudtasks/v2"
+	"google.golang.org/api/cloudtrace/v1"
 	"google.golang.org/api/composer/v1"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
+	"google.golang.org/api/containeranalysis/v1"
+	"google.golang.org/api/datafusion/v1"
 	"google.golang.org/api/dataplex/v1"
 	"google.golang.org/api/dataproc/v1"
 	"google.golang.org/api/dns/v1"
 	"google.golang.org/api/essentialcontacts/v1"
+	"google.golang.org/api/eventarc/v1"
+	"google.golang.org/api/file/v1"
 	"google.golang.org/api/firestore/v1"
 	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/iap/v1"
+	"google.golang.org/api/identitytoolkit/v2"
 	"google.golang.org/api/logging/v2"
+	"google.golang.org/api/memcache/v1"
 	"google.golang.org/api/metastore/v1"
+	monitoring1 "google.golang.org/api/monitoring/v1"
 	"google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/networkconnectivity/v1"
+	notebooks1 "google.golang.org/api/notebooks/v1"
+	"google.golang.org/api/notebooks/v2"
 	"google.golang.org/api/option"
 	"google.golang.org/api/pubsub/v1"
-	adminreports "google.golang.org/api/admin/reports/v1"
-	"golang.org/x/oauth2/google"
+	"google.golang.org/api/recommender/v1"
 	run1 "google.golang.org/api/run/v1"
 	"google.golang.org/api/run/v2"
 	"google.golang.org/api/secretmanager/v1"
+	"google.golang.org/api/securitycenter/v1"
+	"google.golang.org/api/servicedirectory/v1"
 	"google.golang.org/api/serviceusage/v1"
+	"google.golang.org/api/sourcerepo/v1"
+	"google.golang.org/api/spanner/v1"
 	"google.golang.org/api/storage/v1"
 	"google.golang.org/api/tpu/v2"
 	"google.golang.org/api/vpcaccess/v1"
+	"google.golang.org/api/workflowexecutions/v1"
+	"google.golang.org/api/workflows/v1"
 
 	computeBeta "google.golang.org/api/compute/v0.beta"
+	serviceusageBeta "google.golang.org/api/serviceusage/v1beta1"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 )
 
@@ -75,13 +104,36 @@ func AccessApprovalService(ctx context.Context, d *plugin.QueryData) (*accessapp
 	return svc, nil
 }
 
+// AccessContextManagerService returns the service connection for GCP Access Context Manager service
+func AccessContextManagerService(ctx context.Context, d *plugin.QueryData) (*accesscontextmanager.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "AccessContextManagerService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*accesscontextmanager.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := accesscontextmanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 type AIplatfromServiceClients struct {
-	Endpoint *aiplatform.EndpointClient
-	Dataset  *aiplatform.DatasetClient
-	Index    *aiplatform.IndexClient
-	Job      *aiplatform.JobClient
-	Model    *aiplatform.ModelClient
-	Notebook *aiplatform.NotebookClient
+	Endpoint     *aiplatform.EndpointClient
+	Dataset      *aiplatform.DatasetClient
+	Featurestore *aiplatform.FeaturestoreClient
+	Index        *aiplatform.IndexClient
+	Job          *aiplatform.JobClient
+	Model        *aiplatform.ModelClient
+	Notebook     *aiplatform.NotebookClient
+	Pipeline     *aiplatform.PipelineClient
 }
 
 func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AIplatfromServiceClients, error) {
@@ -119,6 +171,13 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		}
 		clients.Dataset = svc
 		return clients, nil
+	case "Featurestore":
+		svc, err := aiplatform.NewFeaturestoreClient(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		clients.Featurestore = svc
+		return clients, nil
 	case "Index":
 		svc, err := aiplatform.NewIndexClient(ctx, opts...)
 		if err != nil {
@@ -147,6 +206,13 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		}
 		clients.Notebook = svc
 		return clients, nil
+	case "Pipeline":
+		svc, err := aiplatform.NewPipelineClient(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		clients.Pipeline = svc
+		return clients, nil
 	}
 
 	d.ConnectionManager.Cache.Set(serviceCacheKey, clients)
@@ -197,7 +263,7 @@ func APIKeysService(ctx context.Context, d *plugin.QueryData) (*apikeys.Service,
 // AppEngineService returns the service connection for GCP App Engine service
 func AppEngineService(ctx context.Context, d *plugin.QueryData) (*appengine.APIService, error) {
 	// have we already created and cached the service?
-	serviceCacheKey := "BillingBudgetsService"
+	serviceCacheKey := "AppEngineService"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*appengine.APIService), nil
 	}
@@ -278,6 +344,27 @@ func BigQueryService(ctx context.Context, d *plugin.QueryData) (*bigquery.Servic
 	return svc, nil
 }
 
+// BigQueryReservationService returns the service connection for GCP BigQuery Reservation service
+func BigQueryReservationService(ctx context.Context, d *plugin.QueryData) (*bigqueryreservation.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "BigQueryReservationService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*bigqueryreservation.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := bigqueryreservation.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // ArtifactRegistryService returns the service connection for GCP ArtifactRegistry service
 func ArtifactRegistryService(ctx context.Context, d *plugin.QueryData) (*artifactregistry.Service, error) {
 	// have we already created and cached the service?
@@ -320,6 +407,153 @@ func BigtableAdminService(ctx context.Context, d *plugin.QueryData) (*bigtablead
 	return svc, nil
 }
 
+// CertificateManagerService returns the service connection for GCP Certificate Manager service
+func CertificateManagerService(ctx context.Context, d *plugin.QueryData) (*certificatemanager.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CertificateManagerService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*certificatemanager.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := certificatemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudBuildService returns the service connection for GCP Cloud Build service
+func CloudBuildService(ctx context.Context, d *plugin.QueryData) (*cloudbuild.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudBuildService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudbuild.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := cloudbuild.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudDeployService returns the service connection for GCP Cloud Deploy service
+func CloudDeployService(ctx context.Context, d *plugin.QueryData) (*clouddeploy.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudDeployService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*clouddeploy.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := clouddeploy.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudProfilerService returns the service connection for GCP Cloud Profiler service
+func CloudProfilerService(ctx context.Context, d *plugin.QueryData) (*cloudprofiler.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudProfilerService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudprofiler.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := cloudprofiler.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudTraceService returns the service connection for GCP Cloud Trace service
+func CloudTraceService(ctx context.Context, d *plugin.QueryData) (*cloudtrace.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudTraceService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudtrace.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := cloudtrace.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudSchedulerService returns the service connection for GCP Cloud Scheduler service
+func CloudSchedulerService(ctx context.Context, d *plugin.QueryData) (*cloudscheduler.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudSchedulerService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudscheduler.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := cloudscheduler.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudTasksService returns the service connection for GCP Cloud Tasks service
+func CloudTasksService(ctx context.Context, d *plugin.QueryData) (*cloudtasks.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudTasksService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudtasks.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := cloudtasks.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // CloudResourceManagerService returns the service connection for GCP Cloud Resource Manager service
 func CloudResourceManagerService(ctx context.Context, d *plugin.QueryData) (*cloudresourcemanager.Service, error) {
 	// have we already created and cached the service?
@@ -341,6 +575,27 @@ func CloudResourceManagerService(ctx context.Context, d *plugin.QueryData) (*clo
 	return svc, nil
 }
 
+// CloudResourceManagerServiceV3 returns the service connection for GCP Cloud Resource Manager V3 service
+func CloudResourceManagerServiceV3(ctx context.Context, d *plugin.QueryData) (*cloudresourcemanager3.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudResourceManagerServiceV3"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudresourcemanager3.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := cloudresourcemanager3.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // CloudRunService returns the service connection for GCP Cloud Run service
 func CloudRunService(ctx context.Context, d *plugin.QueryData) (*run.Service, error) {
 	// have we already created and cached the service?
@@ -383,6 +638,27 @@ func CloudRunServiceV1(ctx context.Context, d *plugin.QueryData) (*run1.APIServi
 	return svc, nil
 }
 
+// DataFusionService returns the service connection for GCP Data Fusion service
+func DataFusionService(ctx context.Context, d *plugin.QueryData) (*datafusion.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "DataFusionService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*datafusion.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := datafusion.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // DataplexService returns the service connection for GCP Dataplex service
 func DataplexService(ctx context.Context, d *plugin.QueryData) (*dataplex.Service, error) {
 	// have we already created and cached the service?
@@ -425,6 +701,27 @@ func EssentialContactService(ctx context.Context, d *plugin.QueryData) (*essenti
 	return svc, nil
 }
 
+// EventarcService returns the service connection for GCP Eventarc service
+func EventarcService(ctx context.Context, d *plugin.QueryData) (*eventarc.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "EventarcService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*eventarc.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := eventarc.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // CloudSQLAdminService returns the service connection for GCP Cloud SQL Admin service
 func CloudSQLAdminService(ctx context.Context, d *plugin.QueryData) (*sqladmin.Service, error) {
 	// have we already created and cached the service?
@@ -572,6 +869,27 @@ func ContainerService(ctx context.Context, d *plugin.QueryData) (*container.Serv
 	return svc, nil
 }
 
+// ContainerAnalysisService returns the service connection for GCP Container Analysis service
+func ContainerAnalysisService(ctx context.Context, d *plugin.QueryData) (*containeranalysis.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "ContainerAnalysisService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*containeranalysis.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := containeranalysis.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // CloudFunctionsService returns the service connection for GCP Cloud Functions service
 func CloudFunctionsService(ctx context.Context, d *plugin.QueryData) (*cloudfunctions.Service, error) {
 	// have we already created and cached the service?
@@ -656,6 +974,27 @@ func DnsService(ctx context.Context, d *plugin.QueryData) (*dns.Service, error)
 	return svc, nil
 }
 
+// FilestoreService returns the service connection for GCP Filestore service
+func FilestoreService(ctx context.Context, d *plugin.QueryData) (*file.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "FilestoreService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*file.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := file.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // FirestoreDatabaseService returns the service connection for GCP Firestore service
 func FirestoreDatabaseService(ctx context.Context, d *plugin.QueryData) (*firestore.Service, error) {
 	// have we already created and cached the service?
@@ -698,19 +1037,19 @@ func IAMService(ctx context.Context, d *plugin.QueryData) (*iam.Service, error)
 	return svc, nil
 }
 
-// LoggingService returns the service connection for GCP Logging service
-func LoggingService(ctx context.Context, d *plugin.QueryData) (*logging.Service, error) {
+// IAPService returns the service connection for GCP Identity-Aware Proxy service
+func IAPService(ctx context.Context, d *plugin.QueryData) (*iap.Service, error) {
 	// have we already created and cached the service?
-	serviceCacheKey := "LoggingService"
+	serviceCacheKey := "IAPService"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*logging.Service), nil
+		return cachedData.(*iap.Service), nil
 	}
 
 	// To get config arguments from plugin config file
 	opts := setSessionConfig(ctx, d.Connection)
 
 	// so it was not in cache - create service
-	svc, err := logging.NewService(ctx, opts...)
+	svc, err := iap.NewService(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -719,19 +1058,19 @@ func LoggingService(ctx context.Context, d *plugin.QueryData) (*logging.Service,
 	return svc, nil
 }
 
-// MonitoringService returns the service connection for GCP Monitoring service
-func MonitoringService(ctx context.Context, d *plugin.QueryData) (*monitoring.Service, error) {
+// IdentityPlatformService returns the service connection for GCP Identity Platform service
+func IdentityPlatformService(ctx context.Context, d *plugin.QueryData) (*identitytoolkit.Service, error) {
 	// have we already created and cached the service?
-	serviceCacheKey := "MonitoringService"
+	serviceCacheKey := "IdentityPlatformService"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*monitoring.Service), nil
+		return cachedData.(*identitytoolkit.Service), nil
 	}
 
 	// To get config arguments from plugin config file
 	opts := setSessionConfig(ctx, d.Connection)
 
 	// so it was not in cache - create service
-	svc, err := monitoring.NewService(ctx, opts...)
+	svc, err := identitytoolkit.NewService(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -740,19 +1079,145 @@ func MonitoringService(ctx context.Context, d *plugin.QueryData) (*monitoring.Se
 	return svc, nil
 }
 
-// PubsubService returns the service connection for GCP Pub/Sub service
-func PubsubService(ctx context.Context, d *plugin.QueryData) (*pubsub.Service, error) {
+// LoggingService returns the service connection for GCP Logging service
+func LoggingService(ctx context.Context, d *plugin.QueryData) (*logging.Service, error) {
 	// have we already created and cached the service?
-	serviceCacheKey := "PubsubService"
+	serviceCacheKey := "LoggingService"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*pubsub.Service), nil
+		return cachedData.(*logging.Service), nil
 	}
 
 	// To get config arguments from plugin config file
 	opts := setSessionConfig(ctx, d.Connection)
 
 	// so it was not in cache - create service
-	svc, err := pubsub.NewService(ctx, opts...)
+	svc, err := logging.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// MonitoringService returns the service connection for GCP Monitoring service
+func MonitoringService(ctx context.Context, d *plugin.QueryData) (*monitoring.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "MonitoringService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*monitoring.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := monitoring.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// MonitoringServiceV1 returns the service connection for GCP Monitoring V1 service
+func MonitoringServiceV1(ctx context.Context, d *plugin.QueryData) (*monitoring1.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "MonitoringServiceV1"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*monitoring1.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := monitoring1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// NetworkConnectivityService returns the service connection for GCP Network Connectivity service
+func NetworkConnectivityService(ctx context.Context, d *plugin.QueryData) (*networkconnectivity.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "NetworkConnectivityService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*networkconnectivity.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := networkconnectivity.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// NotebooksService returns the service connection for GCP Notebooks service
+func NotebooksService(ctx context.Context, d *plugin.QueryData) (*notebooks.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "NotebooksService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*notebooks.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := notebooks.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// NotebooksServiceV1 returns the service connection for GCP Notebooks V1 service
+func NotebooksServiceV1(ctx context.Context, d *plugin.QueryData) (*notebooks1.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "NotebooksServiceV1"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*notebooks1.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := notebooks1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// PubsubService returns the service connection for GCP Pub/Sub service
+func PubsubService(ctx context.Context, d *plugin.QueryData) (*pubsub.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "PubsubService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*pubsub.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := pubsub.NewService(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -761,61 +1226,164 @@ func PubsubService(ctx context.Context, d *plugin.QueryData) (*pubsub.Service, e
 	return svc, nil
 }
 
-// ReportsService crée et met en cache le service Admin Reports API,
+// RecommenderService returns the service connection for GCP Recommender service
+func RecommenderService(ctx context.Context, d *plugin.QueryData) (*recommender.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "RecommenderService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*recommender.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := recommender.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// ReportsService crée et met en cache le service Admin Reports API pour les journaux d’audit (activités),
 // Utilise GetConfig pour obtenir gcpConfig déjà décodé.
 func ReportsService(ctx context.Context, d *plugin.QueryData) (*adminreports.Service, error) {
-    const cacheKey = "AdminReportsService"
-    if cached, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
-        return cached.(*adminreports.Service), nil
-    }
-
-    // 1. Récupérer la configuration décodée
-    connConfig := GetConfig(d.Connection)
-
-    // 2. Récupérer et valider le chemin vers le JSON du service account
-    if connConfig.Credentials == nil || *connConfig.Credentials == "" {
-        return nil, fmt.Errorf("ReportsService: 'credentials' must be set in connection config")
-    }
-    credsPath := *connConfig.Credentials
-    // Étendre "~" si nécessaire
-    if strings.HasPrefix(credsPath, "~") {
-        home, err := os.UserHomeDir()
-        if err != nil {
-            return nil, fmt.Errorf("ReportsService: cannot resolve home directory: %w", err)
-        }
-        credsPath = filepath.Join(home, credsPath[1:])
-    }
-    data, err := ioutil.ReadFile(credsPath)
-    if err != nil {
-        return nil, fmt.Errorf("ReportsService: unable to read credentials file %q: %w", credsPath, err)
-    }
-
-    // 3. Récupérer et valider l’email d’impersonation
-    if connConfig.ImpersonateUserEmail == nil || *connConfig.ImpersonateUserEmail == "" {
-        return nil, fmt.Errorf("ReportsService: 'impersonate_user_email' must be set in connection config")
-    }
-    impersonatedUser := *connConfig.ImpersonateUserEmail
-
-    // 4. Créer la config JWT pour Admin Reports Audit readonly
-    jwtConfig, err := google.JWTConfigFromJSON(data, adminreports.AdminReportsAuditReadonlyScope)
-    if err != nil {
-        return nil, fmt.Errorf("ReportsService: JWTConfigFromJSON: %w", err)
-    }
-    jwtConfig.Subject = impersonatedUser
-
-    // 5. Créer le client HTTP OAuth2
-    client := jwtConfig.Client(ctx)
-    // (Optionnel) envelopper client.Transport pour logger HTTP si besoin
-
-    // 6. Créer le service Admin Reports
-    svc, err := adminreports.NewService(ctx, option.WithHTTPClient(client))
-    if err != nil {
-        return nil, fmt.Errorf("ReportsService: NewService: %w", err)
-    }
-
-    // 7. Mettre en cache l’instance
-    d.ConnectionManager.Cache.Set(cacheKey, svc)
-    return svc, nil
+	return reportsService(ctx, d, "AdminReportsService", adminreports.AdminReportsAuditReadonlyScope)
+}
+
+// ReportsUsageService crée et met en cache le service Admin Reports API pour les rapports d’utilisation.
+// Le scope usage est demandé séparément afin de ne pas casser les connexions dont la délégation
+// n’autorise que le scope audit.
+func ReportsUsageService(ctx context.Context, d *plugin.QueryData) (*adminreports.Service, error) {
+	return reportsService(ctx, d, "AdminReportsUsageService", adminreports.AdminReportsUsageReadonlyScope)
+}
+
+// DirectoryService crée et met en cache le service Admin SDK Directory API pour le scope donné.
+// Chaque table ne demande que le scope en lecture seule dont elle a besoin.
+func DirectoryService(ctx context.Context, d *plugin.QueryData, scope string) (*admin.Service, error) {
+	cacheKey := "AdminDirectoryService" + scope
+	if cached, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cached.(*admin.Service), nil
+	}
+
+	client, err := workspaceHTTPClient(ctx, d, scope)
+	if err != nil {
+		return nil, fmt.Errorf("DirectoryService: %w", err)
+	}
+
+	svc, err := admin.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("DirectoryService: NewService: %w", err)
+	}
+
+	// Mettre en cache l’instance
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+	return svc, nil
+}
+
+// AlertCenterService crée et met en cache le service Google Workspace Alert Center API.
+func AlertCenterService(ctx context.Context, d *plugin.QueryData) (*alertcenter.Service, error) {
+	cacheKey := "AlertCenterService"
+	if cached, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cached.(*alertcenter.Service), nil
+	}
+
+	client, err := workspaceHTTPClient(ctx, d, alertcenter.AppsAlertsScope)
+	if err != nil {
+		return nil, fmt.Errorf("AlertCenterService: %w", err)
+	}
+
+	svc, err := alertcenter.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("AlertCenterService: NewService: %w", err)
+	}
+
+	// Mettre en cache l’instance
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+	return svc, nil
+}
+
+func reportsService(ctx context.Context, d *plugin.QueryData, cacheKey string, scope string) (*adminreports.Service, error) {
+	if cached, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cached.(*adminreports.Service), nil
+	}
+
+	client, err := workspaceHTTPClient(ctx, d, scope)
+	if err != nil {
+		return nil, fmt.Errorf("ReportsService: %w", err)
+	}
+
+	svc, err := adminreports.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("ReportsService: NewService: %w", err)
+	}
+
+	// Mettre en cache l’instance
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+	return svc, nil
+}
+
+// workspaceHTTPClient crée un client HTTP OAuth2 qui impersonne un administrateur Google Workspace
+// via la délégation au niveau du domaine, pour les API Admin SDK.
+// Les scopes passés en paramètre sont remplacés par admin_scopes lorsque cette option est renseignée.
+func workspaceHTTPClient(ctx context.Context, d *plugin.QueryData, scopes ...string) (*http.Client, error) {
+	// 1. Récupérer la configuration décodée
+	connConfig := GetConfig(d.Connection)
+
+	// 2. Récupérer le JSON du service account : chemin ou contenu de "credentials",
+	// à défaut la variable d’environnement GOOGLE_APPLICATION_CREDENTIALS
+	credentials := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	if connConfig.Credentials != nil && *connConfig.Credentials != "" {
+		credentials = *connConfig.Credentials
+	}
+	if credentials == "" {
+		return nil, fmt.Errorf("'credentials' must be set in connection config to use domain-wide delegation")
+	}
+	data, err := pathOrContents(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read credentials: %w", err)
+	}
+
+	// 3. Récupérer et valider l’email d’impersonation
+	if connConfig.ImpersonateUserEmail == nil || *connConfig.ImpersonateUserEmail == "" {
+		return nil, fmt.Errorf("'impersonate_user_email' must be set in connection config")
+	}
+
+	// 4. Créer la config JWT pour les scopes demandés (ou ceux de admin_scopes)
+	if len(connConfig.AdminScopes) > 0 {
+		scopes = connConfig.AdminScopes
+	}
+	jwtConfig, err := google.JWTConfigFromJSON([]byte(data), scopes...)
+	if err != nil {
+		return nil, fmt.Errorf("JWTConfigFromJSON: %w", err)
+	}
+	jwtConfig.Subject = *connConfig.ImpersonateUserEmail
+
+	// 5. Créer le client HTTP OAuth2
+	return jwtConfig.Client(ctx), nil
+}
+
+// ServiceDirectoryService returns the service connection for GCP Service Directory service
+func ServiceDirectoryService(ctx context.Context, d *plugin.QueryData) (*servicedirectory.APIService, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "ServiceDirectoryService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*servicedirectory.APIService), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := servicedirectory.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
 }
 
 // ServiceUsageService returns the service connection for GCP Service Usage service
@@ -839,6 +1407,27 @@ func ServiceUsageService(ctx context.Context, d *plugin.QueryData) (*serviceusag
 	return svc, nil
 }
 
+// ServiceUsageBetaService returns the service connection for GCP Service Usage service beta version
+func ServiceUsageBetaService(ctx context.Context, d *plugin.QueryData) (*serviceusageBeta.APIService, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "ServiceUsageBetaService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*serviceusageBeta.APIService), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := serviceusageBeta.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 // StorageService returns the service connection for GCP Storage service
 func StorageService(ctx context.Context, d *plugin.QueryData) (*storage.Service, error) {
 	// have we already created and cached the service?
@@ -923,6 +1512,69 @@ func RedisClusterService(ctx context.Context, d *plugin.QueryData) (*rediscluste
 	return svc, nil
 }
 
+// MemcacheService returns the service connection for GCP Memorystore for Memcached service
+func MemcacheService(ctx context.Context, d *plugin.QueryData) (*memcache.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "MemcacheService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*memcache.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := memcache.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// SourceRepoService returns the service connection for GCP Cloud Source Repositories service
+func SourceRepoService(ctx context.Context, d *plugin.QueryData) (*sourcerepo.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "SourceRepoService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*sourcerepo.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := sourcerepo.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// SpannerService returns the service connection for GCP Spanner service
+func SpannerService(ctx context.Context, d *plugin.QueryData) (*spanner.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "SpannerService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*spanner.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := spanner.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 func SecretManagerService(ctx context.Context, d *plugin.QueryData) (*secretmanager.Service, error) {
 	// have we already created and cached the service?
 	serviceCacheKey := "SecretManagerService"
@@ -943,6 +1595,27 @@ func SecretManagerService(ctx context.Context, d *plugin.QueryData) (*secretmana
 	return svc, nil
 }
 
+// SecurityCenterService returns the service connection for GCP Security Command Center service
+func SecurityCenterService(ctx context.Context, d *plugin.QueryData) (*securitycenter.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "SecurityCenterService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*securitycenter.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := securitycenter.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
 func VPCAccessService(ctx context.Context, d *plugin.QueryData) (*vpcaccess.Service, error) {
 	// have we already created and cached the service?
 	serviceCacheKey := "VPCAccessService"
@@ -983,3 +1656,45 @@ func TPUService(ctx context.Context, d *plugin.QueryData) (*tpu.Service, error)
 	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
 	return svc, nil
 }
+
+// WorkflowsService returns the service connection for GCP Workflows service
+func WorkflowsService(ctx context.Context, d *plugin.QueryData) (*workflows.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "WorkflowsService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*workflows.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := workflows.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// WorkflowExecutionsService returns the service connection for GCP Workflow Executions service
+func WorkflowExecutionsService(ctx context.Context, d *plugin.QueryData) (*workflowexecutions.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "WorkflowExecutionsService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*workflowexecutions.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := workflowexecutions.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
@@ -0,0 +1,209 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/accesscontextmanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAccessContextManagerAccessLevel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_access_context_manager_access_level",
+		Description: "GCP Access Context Manager Access Level",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "access_policy_name"}),
+			Hydrate:    getAccessContextManagerAccessLevel,
+			Tags:       map[string]string{"service": "accesscontextmanager", "action": "accessLevels.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAccessContextManagerAccessPolicies,
+			Hydrate:       listAccessContextManagerAccessLevels,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+				{Name: "access_policy_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "accesscontextmanager", "action": "accessLevels.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The short name of the access level.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "access_policy_name",
+				Description: "The identifier of the access policy the access level belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+			{
+				Name:        "title",
+				Description: "The human readable title of the access level.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the access level.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "level_type",
+				Description: "The type of the access level, BASIC for a list of conditions, or CUSTOM for a Common Expression Language expression.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(accessContextManagerAccessLevelType),
+			},
+			{
+				Name:        "combining_function",
+				Description: "How the conditions of a basic access level are combined (AND or OR).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Basic.CombiningFunction"),
+			},
+			{
+				Name:        "custom_expression",
+				Description: "The Common Expression Language expression of a custom access level, for example device.is_corp_owned_device == true.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Custom.Expr.Expression"),
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization used to list the access policies. Defaults to the organization of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the access level.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "basic_conditions",
+				Description: "The conditions of a basic access level, such as the IP subnetworks, the device policy, the members and the regions allowed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Basic.Conditions"),
+			},
+			{
+				Name:        "custom",
+				Description: "The custom access level, defined by a Common Expression Language expression.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "accesscontextmanager.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAccessContextManagerAccessLevels(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(*accesscontextmanager.AccessPolicy)
+
+	// Minimize the API call with the given access policy name
+	policyName := d.EqualsQualString("access_policy_name")
+	if policyName != "" && policyName != getLastPathElement(policy.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AccessContextManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_level.listAccessContextManagerAccessLevels", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.AccessPolicies.AccessLevels.List(policy.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *accesscontextmanager.ListAccessLevelsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, level := range page.AccessLevels {
+			d.StreamListItem(ctx, level)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_level.listAccessContextManagerAccessLevels", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAccessContextManagerAccessLevel(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	policyName := d.EqualsQualString("access_policy_name")
+
+	// Empty Check
+	if name == "" || policyName == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AccessContextManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_level.getAccessContextManagerAccessLevel", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.AccessPolicies.AccessLevels.Get("accessPolicies/" + policyName + "/accessLevels/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_level.getAccessContextManagerAccessLevel", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func accessContextManagerAccessLevelType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	level := d.HydrateItem.(*accesscontextmanager.AccessLevel)
+
+	switch {
+	case level.Basic != nil:
+		return "BASIC", nil
+	case level.Custom != nil:
+		return "CUSTOM", nil
+	}
+	return nil, nil
+}
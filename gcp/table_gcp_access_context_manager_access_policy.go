@@ -0,0 +1,207 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/accesscontextmanager/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAccessContextManagerAccessPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_access_context_manager_access_policy",
+		Description: "GCP Access Context Manager Access Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getAccessContextManagerAccessPolicy,
+			Tags:       map[string]string{"service": "accesscontextmanager", "action": "accessPolicies.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAccessContextManagerAccessPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "accesscontextmanager", "action": "accessPolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the access policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "title",
+				Description: "The human readable title of the access policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization the access policy belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parent").Transform(lastPathElement),
+			},
+			{
+				Name:        "parent",
+				Description: "The parent of the access policy, in the form organizations/{organization_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An opaque identifier for the current version of the access policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the access policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "scopes",
+				Description: "The folder or project the scoped access policy applies to, in the form folders/{folder_number} or projects/{project_number}. Empty for the organization-level access policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "accesscontextmanager.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAccessContextManagerAccessPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := AccessContextManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_policy.listAccessContextManagerAccessPolicies", "service_error", err)
+		return nil, err
+	}
+
+	organizationId, err := getAccessPolicyOrganizationId(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_policy.listAccessContextManagerAccessPolicies", "organization_error", err)
+		return nil, err
+	}
+
+	// The project does not belong to an organization
+	if organizationId == "" {
+		return nil, nil
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.AccessPolicies.List().Parent("organizations/" + organizationId).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *accesscontextmanager.ListAccessPoliciesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, policy := range page.AccessPolicies {
+			d.StreamListItem(ctx, policy)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_policy.listAccessContextManagerAccessPolicies", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAccessContextManagerAccessPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AccessContextManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_policy.getAccessContextManagerAccessPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.AccessPolicies.Get("accessPolicies/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_access_policy.getAccessContextManagerAccessPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The access policies are defined at the organization level. The given
+// organization is used if any, else the organization of the project
+func getAccessPolicyOrganizationId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (string, error) {
+	if organizationId := d.EqualsQualString("organization_id"); organizationId != "" {
+		return organizationId, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return "", err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.GetAncestry(project, &cloudresourcemanager.GetAncestryRequest{}).Do()
+	if err != nil {
+		return "", err
+	}
+
+	for _, ancestor := range resp.Ancestor {
+		if ancestor.ResourceId != nil && ancestor.ResourceId.Type == "organization" {
+			return ancestor.ResourceId.Id, nil
+		}
+	}
+
+	return "", nil
+}
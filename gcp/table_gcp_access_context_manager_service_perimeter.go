@@ -0,0 +1,227 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/accesscontextmanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAccessContextManagerServicePerimeter(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_access_context_manager_service_perimeter",
+		Description: "GCP Access Context Manager Service Perimeter",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "access_policy_name"}),
+			Hydrate:    getAccessContextManagerServicePerimeter,
+			Tags:       map[string]string{"service": "accesscontextmanager", "action": "servicePerimeters.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAccessContextManagerAccessPolicies,
+			Hydrate:       listAccessContextManagerServicePerimeters,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+				{Name: "access_policy_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "accesscontextmanager", "action": "servicePerimeters.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The short name of the service perimeter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "access_policy_name",
+				Description: "The identifier of the access policy the service perimeter belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+			{
+				Name:        "title",
+				Description: "The human readable title of the service perimeter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the service perimeter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "perimeter_type",
+				Description: "The type of the service perimeter (PERIMETER_TYPE_REGULAR or PERIMETER_TYPE_BRIDGE). A perimeter bridge allows the projects of several regular perimeters to communicate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "use_explicit_dry_run_spec",
+				Description: "Specifies whether the dry-run configuration of the service perimeter is defined in the spec, independently of its enforced status.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "etag",
+				Description: "An opaque identifier for the current version of the service perimeter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization used to list the access policies. Defaults to the organization of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the service perimeter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "resources",
+				Description: "The projects and VPC networks protected by the enforced configuration of the service perimeter, in the form projects/{project_number}.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Status.Resources"),
+			},
+			{
+				Name:        "restricted_services",
+				Description: "The Google Cloud services restricted by the enforced configuration of the service perimeter, for example storage.googleapis.com.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Status.RestrictedServices"),
+			},
+			{
+				Name:        "access_levels",
+				Description: "The access levels that allow requests from outside of the service perimeter, in its enforced configuration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Status.AccessLevels"),
+			},
+			{
+				Name:        "ingress_policies",
+				Description: "The ingress policies that allow access to the resources of the service perimeter from outside of it, in its enforced configuration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Status.IngressPolicies"),
+			},
+			{
+				Name:        "egress_policies",
+				Description: "The egress policies that allow access from the resources of the service perimeter to resources outside of it, in its enforced configuration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Status.EgressPolicies"),
+			},
+			{
+				Name:        "vpc_accessible_services",
+				Description: "The services that can be accessed from the VPC networks of the service perimeter, in its enforced configuration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Status.VpcAccessibleServices"),
+			},
+			{
+				Name:        "status",
+				Description: "The enforced configuration of the service perimeter.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "spec",
+				Description: "The dry-run configuration of the service perimeter, when use_explicit_dry_run_spec is true.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "accesscontextmanager.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAccessContextManagerServicePerimeters(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(*accesscontextmanager.AccessPolicy)
+
+	// Minimize the API call with the given access policy name
+	policyName := d.EqualsQualString("access_policy_name")
+	if policyName != "" && policyName != getLastPathElement(policy.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AccessContextManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_service_perimeter.listAccessContextManagerServicePerimeters", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.AccessPolicies.ServicePerimeters.List(policy.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *accesscontextmanager.ListServicePerimetersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, perimeter := range page.ServicePerimeters {
+			d.StreamListItem(ctx, perimeter)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_service_perimeter.listAccessContextManagerServicePerimeters", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAccessContextManagerServicePerimeter(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	policyName := d.EqualsQualString("access_policy_name")
+
+	// Empty Check
+	if name == "" || policyName == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AccessContextManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_service_perimeter.getAccessContextManagerServicePerimeter", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.AccessPolicies.ServicePerimeters.Get("accessPolicies/" + policyName + "/servicePerimeters/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_access_context_manager_service_perimeter.getAccessContextManagerServicePerimeter", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,103 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryAsp définit la table Steampipe pour les mots de passe d’application d’un utilisateur (Directory API).
+func tableGcpAdminDirectoryAsp(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_asp",
+		Description: "GCP Admin Directory API - mots de passe d’application (ASP) d’un utilisateur",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminDirectoryAsps,
+			KeyColumns: plugin.SingleColumn("user_key"),
+			Tags:       map[string]string{"service": "admin", "product": "directory", "action": "asps.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user_key",
+				Description: "Email ou identifiant de l’utilisateur (qualifier obligatoire)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_key"),
+			},
+			{
+				Name:        "code_id",
+				Description: "Identifiant unique du mot de passe d’application",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "Nom donné par l’utilisateur au mot de passe d’application",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "Date de création du mot de passe d’application",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "last_time_used",
+				Description: "Date de dernière utilisation du mot de passe d’application",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastTimeUsed").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "user_id",
+				Description: "Identifiant unique de l’utilisateur propriétaire du mot de passe d’application",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserKey"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryAsps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userKey := d.EqualsQualString("user_key")
+	if userKey == "" {
+		return nil, nil
+	}
+
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryUserSecurityScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_asp.listGcpAdminDirectoryAsps", "service_error", err)
+		return nil, err
+	}
+
+	// asps.list n’est pas paginé : tous les mots de passe d’application sont retournés en une fois
+	resp, err := service.Asps.List(userKey).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_asp.listGcpAdminDirectoryAsps", "api_error", err)
+		return nil, err
+	}
+
+	for _, asp := range resp.Items {
+		d.StreamListItem(ctx, asp)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,194 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryChromeosDevice définit la table Steampipe pour les appareils ChromeOS gérés (Directory API).
+func tableGcpAdminDirectoryChromeosDevice(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_chromeos_device",
+		Description: "GCP Admin Directory API - appareils ChromeOS gérés par Google Workspace",
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryChromeosDevices,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query", Require: plugin.Optional},
+				{Name: "org_unit_path", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "directory", "action": "chromeosdevices.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "device_id",
+				Description: "Identifiant unique de l’appareil ChromeOS",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "serial_number",
+				Description: "Numéro de série de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Statut de l’appareil (ex: ACTIVE, DEPROVISIONED, DISABLED)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "model",
+				Description: "Modèle de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "os_version",
+				Description: "Version de ChromeOS installée",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform_version",
+				Description: "Version de la plateforme ChromeOS",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firmware_version",
+				Description: "Version du firmware de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "boot_mode",
+				Description: "Mode de démarrage de l’appareil (Verified ou Dev)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "org_unit_path",
+				Description: "Chemin de l’unité organisationnelle de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "org_unit_id",
+				Description: "Identifiant de l’unité organisationnelle de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "annotated_user",
+				Description: "Utilisateur annoté par l’administrateur",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "annotated_location",
+				Description: "Emplacement annoté par l’administrateur",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "annotated_asset_id",
+				Description: "Identifiant d’inventaire annoté par l’administrateur",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "notes",
+				Description: "Notes ajoutées par l’administrateur",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_sync",
+				Description: "Date de la dernière synchronisation de l’appareil",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_enrollment_time",
+				Description: "Date du dernier enrôlement de l’appareil",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "auto_update_expiration",
+				Description: "Date de fin des mises à jour automatiques de l’appareil",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AutoUpdateExpiration").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "mac_address",
+				Description: "Adresse MAC Wi-Fi de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ethernet_mac_address",
+				Description: "Adresse MAC Ethernet de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recent_users",
+				Description: "Liste des derniers utilisateurs de l’appareil, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "active_time_ranges",
+				Description: "Temps d’utilisation de l’appareil par jour, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "query",
+				Description: "Requête de recherche transmise à l’API (ex: 'status:provisioned sync:2024-01-01..')",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SerialNumber"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryChromeosDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryDeviceChromeosReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_chromeos_device.listGcpAdminDirectoryChromeosDevices", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := int64(300)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	call := service.Chromeosdevices.List("my_customer").Projection("FULL").MaxResults(pageSize)
+	if query := d.EqualsQualString("query"); query != "" {
+		call.Query(query)
+	}
+	// Sans IncludeChildOrgunits, seuls les appareils de l’unité demandée sont retournés
+	if orgUnitPath := d.EqualsQualString("org_unit_path"); orgUnitPath != "" {
+		call.OrgUnitPath(orgUnitPath)
+	}
+
+	if err := call.Pages(ctx, func(page *admin.ChromeOsDevices) error {
+		for _, device := range page.Chromeosdevices {
+			d.StreamListItem(ctx, device)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_chromeos_device.listGcpAdminDirectoryChromeosDevices", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,117 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryDomain définit la table Steampipe pour les domaines du client Google Workspace (Directory API).
+func tableGcpAdminDirectoryDomain(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_domain",
+		Description: "GCP Admin Directory API - domaines du client Google Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("domain_name"),
+			Hydrate:    getGcpAdminDirectoryDomain,
+			Tags:       map[string]string{"service": "admin", "product": "directory", "action": "domains.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryDomains,
+			Tags:    map[string]string{"service": "admin", "product": "directory", "action": "domains.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "domain_name",
+				Description: "Nom du domaine",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_primary",
+				Description: "Indique si le domaine est le domaine principal du client",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "verified",
+				Description: "Indique si la propriété du domaine a été vérifiée",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "creation_time",
+				Description: "Date d’ajout du domaine au client",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "domain_aliases",
+				Description: "Liste des alias de ce domaine, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryDomainReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain.listGcpAdminDirectoryDomains", "service_error", err)
+		return nil, err
+	}
+
+	// domains.list n’est pas paginé
+	resp, err := service.Domains.List("my_customer").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain.listGcpAdminDirectoryDomains", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range resp.Domains {
+		d.StreamListItem(ctx, domain)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpAdminDirectoryDomain(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	domainName := d.EqualsQualString("domain_name")
+	if domainName == "" {
+		return nil, nil
+	}
+
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryDomainReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain.getGcpAdminDirectoryDomain", "service_error", err)
+		return nil, err
+	}
+
+	domain, err := service.Domains.Get("my_customer", domainName).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain.getGcpAdminDirectoryDomain", "api_error", err)
+		return nil, err
+	}
+
+	return domain, nil
+}
@@ -0,0 +1,120 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryDomainAlias définit la table Steampipe pour les alias de domaine du client Google Workspace (Directory API).
+func tableGcpAdminDirectoryDomainAlias(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_domain_alias",
+		Description: "GCP Admin Directory API - alias de domaine du client Google Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("domain_alias_name"),
+			Hydrate:    getGcpAdminDirectoryDomainAlias,
+			Tags:       map[string]string{"service": "admin", "product": "directory", "action": "domainAliases.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryDomainAliases,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent_domain_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "directory", "action": "domainAliases.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "domain_alias_name",
+				Description: "Nom de l’alias de domaine",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_domain_name",
+				Description: "Nom du domaine auquel l’alias est rattaché",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "verified",
+				Description: "Indique si la propriété de l’alias de domaine a été vérifiée",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "creation_time",
+				Description: "Date d’ajout de l’alias de domaine",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").NullIfZero().Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainAliasName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryDomainAliases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryDomainReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain_alias.listGcpAdminDirectoryDomainAliases", "service_error", err)
+		return nil, err
+	}
+
+	// domainAliases.list n’est pas paginé
+	call := service.DomainAliases.List("my_customer")
+	if parentDomainName := d.EqualsQualString("parent_domain_name"); parentDomainName != "" {
+		call.ParentDomainName(parentDomainName)
+	}
+
+	resp, err := call.Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain_alias.listGcpAdminDirectoryDomainAliases", "api_error", err)
+		return nil, err
+	}
+
+	for _, alias := range resp.DomainAliases {
+		d.StreamListItem(ctx, alias)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpAdminDirectoryDomainAlias(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	aliasName := d.EqualsQualString("domain_alias_name")
+	if aliasName == "" {
+		return nil, nil
+	}
+
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryDomainReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain_alias.getGcpAdminDirectoryDomainAlias", "service_error", err)
+		return nil, err
+	}
+
+	alias, err := service.DomainAliases.Get("my_customer", aliasName).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_domain_alias.getGcpAdminDirectoryDomainAlias", "api_error", err)
+		return nil, err
+	}
+
+	return alias, nil
+}
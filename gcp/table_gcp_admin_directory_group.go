@@ -0,0 +1,178 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryGroup définit la table Steampipe pour les groupes Google Workspace (Directory API).
+func tableGcpAdminDirectoryGroup(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_group",
+		Description: "GCP Admin Directory API - groupes Google Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AnyColumn([]string{"email", "id"}),
+			Hydrate:    getGcpAdminDirectoryGroup,
+			Tags:       map[string]string{"service": "admin", "product": "directory", "action": "groups.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryGroups,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "domain", Require: plugin.Optional},
+				{Name: "member_key", Require: plugin.Optional},
+				{Name: "query", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "directory", "action": "groups.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "email",
+				Description: "Adresse email du groupe",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Identifiant unique du groupe",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "Nom affiché du groupe",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Description du groupe",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direct_members_count",
+				Description: "Nombre de membres directs du groupe (les membres des sous-groupes ne sont pas comptés)",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "admin_created",
+				Description: "Indique si le groupe a été créé par un administrateur plutôt que par un utilisateur",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "aliases",
+				Description: "Liste des alias email du groupe, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "non_editable_aliases",
+				Description: "Liste des alias non modifiables du groupe (domaines secondaires), en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domain",
+				Description: "Domaine transmis à l’API pour restreindre la liste des groupes",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("domain"),
+			},
+			{
+				Name:        "member_key",
+				Description: "Email ou identifiant d’un utilisateur ou groupe : seuls les groupes dont il est membre direct sont retournés",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("member_key"),
+			},
+			{
+				Name:        "query",
+				Description: "Requête de recherche transmise à l’API (ex: 'email:admins*')",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Email"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryGroupReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_group.listGcpAdminDirectoryGroups", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := int64(200)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	call := service.Groups.List().MaxResults(pageSize)
+
+	// userKey ne peut pas être combiné avec customer ou domain ;
+	// le paramètre domain et le paramètre customer sont exclusifs
+	if memberKey := d.EqualsQualString("member_key"); memberKey != "" {
+		call.UserKey(memberKey)
+	} else if domain := d.EqualsQualString("domain"); domain != "" {
+		call.Domain(domain)
+	} else {
+		call.Customer("my_customer")
+	}
+	if query := d.EqualsQualString("query"); query != "" {
+		call.Query(query)
+	}
+
+	if err := call.Pages(ctx, func(page *admin.Groups) error {
+		for _, group := range page.Groups {
+			d.StreamListItem(ctx, group)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_group.listGcpAdminDirectoryGroups", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpAdminDirectoryGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	groupKey := d.EqualsQualString("email")
+	if groupKey == "" {
+		groupKey = d.EqualsQualString("id")
+	}
+	if groupKey == "" {
+		return nil, nil
+	}
+
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryGroupReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_group.getGcpAdminDirectoryGroup", "service_error", err)
+		return nil, err
+	}
+
+	group, err := service.Groups.Get(groupKey).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_group.getGcpAdminDirectoryGroup", "api_error", err)
+		return nil, err
+	}
+
+	return group, nil
+}
@@ -0,0 +1,204 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryMobileDevice définit la table Steampipe pour les appareils mobiles gérés (Directory API).
+func tableGcpAdminDirectoryMobileDevice(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_mobile_device",
+		Description: "GCP Admin Directory API - appareils mobiles gérés par Google Workspace",
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryMobileDevices,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "directory", "action": "mobiledevices.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "Identifiant unique de l’appareil mobile dans l’API",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "device_id",
+				Description: "Identifiant de l’appareil (numéro de série pour iOS, Android ID sinon)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "serial_number",
+				Description: "Numéro de série de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Statut de l’appareil (ex: APPROVED, PENDING, BLOCKED, WIPED)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Type d’appareil (ex: ANDROID, IOS_SYNC, GOOGLE_SYNC)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "model",
+				Description: "Modèle de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "brand",
+				Description: "Marque de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "manufacturer",
+				Description: "Fabricant de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "os",
+				Description: "Système d’exploitation et version de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_patch_level",
+				Description: "Niveau de correctif de sécurité Android (horodatage en millisecondes)",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "first_sync",
+				Description: "Date de la première synchronisation de l’appareil",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_sync",
+				Description: "Date de la dernière synchronisation de l’appareil",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "email",
+				Description: "Liste des emails des propriétaires de l’appareil, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "name",
+				Description: "Liste des noms des propriétaires de l’appareil, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "device_compromised_status",
+				Description: "Indique si l’appareil est compromis (root ou jailbreak)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "device_password_status",
+				Description: "Statut du mot de passe de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "encryption_status",
+				Description: "Statut du chiffrement de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "developer_options_status",
+				Description: "Indique si les options développeur sont activées (Android)",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "unknown_sources_status",
+				Description: "Indique si l’installation d’applications de sources inconnues est autorisée (Android)",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "adb_status",
+				Description: "Indique si le débogage USB (adb) est activé (Android)",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "hardware_id",
+				Description: "Identifiant matériel de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "imei",
+				Description: "Numéro IMEI de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_agent",
+				Description: "User agent de l’appareil",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "applications",
+				Description: "Liste des applications installées sur l’appareil (Android), en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "query",
+				Description: "Requête de recherche transmise à l’API (ex: 'status:approved model:pixel')",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Model"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryMobileDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryDeviceMobileReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_mobile_device.listGcpAdminDirectoryMobileDevices", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := int64(100)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	// La projection FULL est nécessaire pour obtenir les applications et les statuts de sécurité
+	call := service.Mobiledevices.List("my_customer").Projection("FULL").MaxResults(pageSize)
+	if query := d.EqualsQualString("query"); query != "" {
+		call.Query(query)
+	}
+
+	if err := call.Pages(ctx, func(page *admin.MobileDevices) error {
+		for _, device := range page.Mobiledevices {
+			d.StreamListItem(ctx, device)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_mobile_device.listGcpAdminDirectoryMobileDevices", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,163 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryRole définit la table Steampipe pour les rôles d’administration Google Workspace (Directory API).
+func tableGcpAdminDirectoryRole(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_role",
+		Description: "GCP Admin Directory API - rôles d’administration Google Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("role_id"),
+			Hydrate:    getGcpAdminDirectoryRole,
+			Tags:       map[string]string{"service": "admin", "product": "directory", "action": "roles.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryRoles,
+			Tags:    map[string]string{"service": "admin", "product": "directory", "action": "roles.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "role_id",
+				Description: "Identifiant unique du rôle",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role_name",
+				Description: "Nom du rôle",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_description",
+				Description: "Description du rôle",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_super_admin_role",
+				Description: "Indique si le rôle est le rôle super administrateur",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_system_role",
+				Description: "Indique si le rôle est un rôle prédéfini par Google",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "role_privileges",
+				Description: "Liste des privilèges accordés par le rôle (privilegeName, serviceId), en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleName"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryRoles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role.listGcpAdminDirectoryRoles", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := int64(100)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	call := service.Roles.List("my_customer").MaxResults(pageSize)
+	if err := call.Pages(ctx, func(page *admin.Roles) error {
+		for _, role := range page.Items {
+			d.StreamListItem(ctx, role)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role.listGcpAdminDirectoryRoles", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpAdminDirectoryRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	roleID := d.EqualsQuals["role_id"].GetInt64Value()
+
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role.getGcpAdminDirectoryRole", "service_error", err)
+		return nil, err
+	}
+
+	role, err := service.Roles.Get("my_customer", fmt.Sprint(roleID)).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role.getGcpAdminDirectoryRole", "api_error", err)
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// La liste des rôles est chargée une seule fois par connexion, puis partagée entre les
+// lignes des tables qui doivent résoudre un role_id (ex: gcp_admin_directory_role_assignment).
+var getAdminDirectoryRolesByIDMemoized = plugin.HydrateFunc(getAdminDirectoryRolesByIDUncached).Memoize(memoize.WithCacheKeyFunction(getAdminDirectoryRolesByIDCacheKey))
+
+func getAdminDirectoryRolesByIDCacheKey(_ context.Context, _ *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return "getAdminDirectoryRolesByID", nil
+}
+
+// getAdminDirectoryRolesByID retourne une map role_id → rôle pour le client Workspace courant.
+func getAdminDirectoryRolesByID(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (map[int64]*admin.Role, error) {
+	roles, err := getAdminDirectoryRolesByIDMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	return roles.(map[int64]*admin.Role), nil
+}
+
+func getAdminDirectoryRolesByIDUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := map[int64]*admin.Role{}
+	if err := service.Roles.List("my_customer").MaxResults(100).Pages(ctx, func(page *admin.Roles) error {
+		for _, role := range page.Items {
+			roles[role.RoleId] = role
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,169 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryRoleAssignment définit la table Steampipe pour les attributions de rôles d’administration Google Workspace (Directory API).
+func tableGcpAdminDirectoryRoleAssignment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_role_assignment",
+		Description: "GCP Admin Directory API - attributions des rôles d’administration Google Workspace",
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminDirectoryRoleAssignments,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "role_id", Require: plugin.Optional},
+				{Name: "user_key", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "directory", "action": "roleAssignments.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getGcpAdminDirectoryRoleAssignmentRole,
+				Tags: map[string]string{"service": "admin", "product": "directory", "action": "roles.list"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "role_assignment_id",
+				Description: "Identifiant unique de l’attribution de rôle",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role_id",
+				Description: "Identifiant du rôle attribué",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role_name",
+				Description: "Nom du rôle attribué",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGcpAdminDirectoryRoleAssignmentRole,
+				Transform:   transform.FromField("RoleName"),
+			},
+			{
+				Name:        "is_super_admin_role",
+				Description: "Indique si le rôle attribué est le rôle super administrateur",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGcpAdminDirectoryRoleAssignmentRole,
+				Transform:   transform.FromField("IsSuperAdminRole"),
+			},
+			{
+				Name:        "role_privileges",
+				Description: "Liste des privilèges accordés par le rôle attribué, en JSON",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGcpAdminDirectoryRoleAssignmentRole,
+				Transform:   transform.FromField("RolePrivileges"),
+			},
+			{
+				Name:        "assigned_to",
+				Description: "Identifiant unique de l’utilisateur ou du groupe auquel le rôle est attribué",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "assignee_type",
+				Description: "Type de bénéficiaire : user ou group",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_type",
+				Description: "Portée de l’attribution : CUSTOMER ou ORG_UNIT",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "org_unit_id",
+				Description: "Identifiant de l’unité organisationnelle lorsque la portée est ORG_UNIT",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "condition",
+				Description: "Condition associée à l’attribution de rôle, le cas échéant",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_key",
+				Description: "Email ou identifiant d’utilisateur transmis à l’API pour filtrer les attributions",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_key"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignmentId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role_assignment.listGcpAdminDirectoryRoleAssignments", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := int64(200)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	call := service.RoleAssignments.List("my_customer").MaxResults(pageSize)
+	// Seule une valeur unique est transmise à l'API ; une liste IN est filtrée par Postgres
+	if roleID, ok := d.EqualsQuals["role_id"].GetValue().(*proto.QualValue_Int64Value); ok {
+		call.RoleId(fmt.Sprint(roleID.Int64Value))
+	}
+	if userKey := d.EqualsQualString("user_key"); userKey != "" {
+		call.UserKey(userKey)
+	}
+
+	if err := call.Pages(ctx, func(page *admin.RoleAssignments) error {
+		for _, assignment := range page.Items {
+			d.StreamListItem(ctx, assignment)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role_assignment.listGcpAdminDirectoryRoleAssignments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getGcpAdminDirectoryRoleAssignmentRole résout le rôle attribué à partir de la liste des rôles mise en cache.
+func getGcpAdminDirectoryRoleAssignmentRole(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	assignment := h.Item.(*admin.RoleAssignment)
+
+	roles, err := getAdminDirectoryRolesByID(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_role_assignment.getGcpAdminDirectoryRoleAssignmentRole", "api_error", err)
+		return nil, err
+	}
+
+	if role, ok := roles[assignment.RoleId]; ok {
+		return role, nil
+	}
+	return nil, nil
+}
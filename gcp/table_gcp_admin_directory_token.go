@@ -0,0 +1,106 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/directory/v1"
+)
+
+// tableGcpAdminDirectoryToken définit la table Steampipe pour les jetons OAuth accordés par un utilisateur à des applications tierces (Directory API).
+func tableGcpAdminDirectoryToken(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_directory_token",
+		Description: "GCP Admin Directory API - jetons OAuth accordés par un utilisateur à des applications tierces",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminDirectoryTokens,
+			KeyColumns: plugin.SingleColumn("user_key"),
+			Tags:       map[string]string{"service": "admin", "product": "directory", "action": "tokens.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user_key",
+				Description: "Email ou identifiant de l’utilisateur (qualifier obligatoire)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_key"),
+			},
+			{
+				Name:        "client_id",
+				Description: "Identifiant client OAuth de l’application",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_text",
+				Description: "Nom affiché de l’application",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "anonymous",
+				Description: "Indique si l’application est enregistrée auprès de Google",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "native_app",
+				Description: "Indique si le jeton a été émis pour une application installée",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "scopes",
+				Description: "Liste des scopes d’autorisation accordés à l’application, en JSON",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_id",
+				Description: "Identifiant unique de l’utilisateur ayant émis le jeton",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserKey"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag de la ressource",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayText"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminDirectoryTokens(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userKey := d.EqualsQualString("user_key")
+	if userKey == "" {
+		return nil, nil
+	}
+
+	service, err := DirectoryService(ctx, d, admin.AdminDirectoryUserSecurityScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_token.listGcpAdminDirectoryTokens", "service_error", err)
+		return nil, err
+	}
+
+	// tokens.list n’est pas paginé : tous les jetons de l’utilisateur sont retournés en une fois
+	resp, err := service.Tokens.List(userKey).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_directory_token.listGcpAdminDirectoryTokens", "api_error", err)
+		return nil, err
+	}
+
+	for _, token := range resp.Items {
+		d.StreamListItem(ctx, token)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
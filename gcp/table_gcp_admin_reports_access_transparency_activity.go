@@ -0,0 +1,84 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsAccessTransparencyActivity définit la table Steampipe pour l’Admin Reports API, activités “access_transparency”.
+func tableGcpAdminReportsAccessTransparencyActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_access_transparency_activity",
+		Description: "GCP Admin Reports API - accès du personnel Google aux données (access_transparency)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsAccessTransparencyActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "resource_name",
+				Description: "Ressource à laquelle le personnel Google a accédé (paramètre RESOURCE_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "RESOURCE_NAME"),
+			},
+			{
+				Name:        "product_name",
+				Description: "Produit Google Workspace concerné par l’accès (paramètre GSUITE_PRODUCT_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "GSUITE_PRODUCT_NAME"),
+			},
+			{
+				Name:        "owner_email",
+				Description: "Adresse email du propriétaire de la ressource (paramètre OWNER_EMAIL)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "OWNER_EMAIL"),
+			},
+			{
+				Name:        "justifications",
+				Description: "Justifications fournies pour l’accès, en JSON (paramètre JUSTIFICATIONS)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameterList, "JUSTIFICATIONS"),
+			},
+			{
+				Name:        "tickets",
+				Description: "Tickets de support associés à l’accès, en JSON (paramètre TICKETS)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameterList, "TICKETS"),
+			},
+			{
+				Name:        "actor_home_office",
+				Description: "Localisation du bureau de l’employé Google (paramètre ACTOR_HOME_OFFICE)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "ACTOR_HOME_OFFICE"),
+			},
+			{
+				Name:        "on_behalf_of",
+				Description: "Adresse email pour le compte de laquelle l’accès a été réalisé (paramètre ON_BEHALF_OF)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "ON_BEHALF_OF"),
+			},
+			{
+				Name:        "access_approval_request_ids",
+				Description: "Identifiants des demandes Access Approval associées, en JSON (paramètre ACCESS_APPROVAL_REQUEST_IDS)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameterList, "ACCESS_APPROVAL_REQUEST_IDS"),
+			},
+			{
+				Name:        "log_id",
+				Description: "Identifiant du journal d’accès (paramètre LOG_ID)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "LOG_ID"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsAccessTransparencyActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "access_transparency")
+}
@@ -1,203 +1,78 @@
-package gcp
-
-import (
-	"context"
-	"time"
-
-	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
-	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
-	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
-)
-
-// tableGcpAdminReportsAdminActivity définit la table Steampipe pour l’Admin Reports API, activités “admin”.
-func tableGcpAdminReportsAdminActivity(ctx context.Context) *plugin.Table {
-	return &plugin.Table{
-		Name:        "gcp_admin_reports_admin_activity",
-		Description: "GCP Admin Reports API - activité de connexion (admin)",
-		List: &plugin.ListConfig{
-			Hydrate: listGcpAdminReportsAdminActivities,
-			KeyColumns: plugin.KeyColumnSlice{
-				{Name: "time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
-				{Name: "actor_email", Require: plugin.Optional},
-				{Name: "ip_address", Require: plugin.Optional},
-				{Name: "event_name", Require: plugin.Optional},
-			},
-			Tags: map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
-		},
-		Columns: []*plugin.Column{
-			{
-				Name:        "time",
-				Description: "Horodatage de l'activité (ID.Time) au format RFC3339",
-				Type:        proto.ColumnType_TIMESTAMP,
-				Transform:   transform.FromField("Id.Time"),
-			},
-			{
-				Name:        "actor_email",
-				Description: "Adresse email de l'acteur (Actor.Email)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Actor.Email"),
-			},
-			{
-    			Name:        "event_name",
-    			Description: "Nom de l’événement",
-    			Type:        proto.ColumnType_STRING,
-    			Transform:   transform.FromField("Events").Transform(extractEventNames),
-			},
-			{
-				Name:        "unique_qualifier",
-				Description: "Identifiant unique qualifiant cette activité (ID.UniqueQualifier)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id.UniqueQualifier"),
-			},
-			{
-				Name:        "application_name",
-				Description: "Nom de l’application du rapport (ici toujours 'admin')",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id.ApplicationName"),
-			},
-			{
-				Name:        "actor_profile_id",
-				Description: "Profile ID de l'acteur (Actor.ProfileId)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Actor.ProfileId"),
-			},
-			{
-				Name:        "actor_caller_type",
-				Description: "Type de caller (Actor.CallerType)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Actor.CallerType"),
-			},
-			{
-				Name:        "ip_address",
-				Description: "Adresse IP associée à l’activité (IpAddress)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("IpAddress"),
-			},
-			{
-				Name:        "events",
-				Description: "Liste des événements détaillés (Events) pour cette activité, en JSON",
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("Events"),
-			},
-			{
-				Name:        "title",
-				Description: "Titre de l’activité (Time + Actor Email)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id.Time").Transform(convertTimeToString).Transform(formatTitleWithActorEmail),
-			},
-			
-		},
-	}
-}
-
-
-
-//// HYDRATE FUNCTIONS
-
-// listGcpAdminReportsAdminActivities liste les activités "admin"
-// Elle gère les qualifiers : time (via StartTime/EndTime), actor_email, ip_address, event_names.
-func listGcpAdminReportsAdminActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-    // Création du service Reports API
-    service, err := ReportsService(ctx, d)
-    if err != nil {
-        plugin.Logger(ctx).Error("gcp_admin_reports_admin_activity.list", "service_error", err)
-        return nil, err
-    }
-
-    call := service.Activities.List("all", "admin")
-
-    // 1. Gestion de la plage temporelle
-    now := time.Now()
-    startTime := now.Add(-180 * 24 * time.Hour)
-    endTime := now
-    if quals := d.Quals["time"]; quals != nil {
-        for _, q := range quals.Quals {
-            if q.Value != nil && q.Value.GetTimestampValue() != nil {
-                t := q.Value.GetTimestampValue().AsTime()
-                switch q.Operator {
-                case "=":
-                    startTime = t
-                    endTime = t
-                case ">":
-                    startTime = t.Add(time.Nanosecond)
-                case ">=":
-                    startTime = t
-                case "<":
-                    endTime = t
-                case "<=":
-                    endTime = t
-                }
-            }
-        }
-    }
-    if !startTime.After(endTime) {
-        call.StartTime(startTime.Format(time.RFC3339))
-        call.EndTime(endTime.Format(time.RFC3339))
-    } else {
-        return nil, nil
-    }
-
-    // 2.
-
-    if quals := d.Quals["event_name"]; quals != nil {
-    for _, q := range quals.Quals {
-        if q.Value != nil {
-            eventName := q.Value.GetStringValue()
-            if eventName != "" {
-                // Utiliser EventName au lieu de Filters 
-                call.EventName(eventName)
-                break
-            }
-        }
-    }
-}
-
-    // 3. Pagination
-    pageToken := ""
-    const apiMaxPageSize = 1000
-    // Déterminer taille de la première page
-    var initialPageSize int64 = apiMaxPageSize
-    if d.QueryContext.Limit != nil {
-        limit := *d.QueryContext.Limit
-        if limit < initialPageSize {
-            initialPageSize = limit
-        }
-    }
-    call.MaxResults(initialPageSize)
-
-    for {
-        if pageToken != "" {
-            call.PageToken(pageToken)
-        }
-        resp, err := call.Do()
-        if err != nil {
-            plugin.Logger(ctx).Error("gcp_admin_reports_admin_activity.list", "api_error", err)
-            return nil, err
-        }
-        if resp.Items != nil {
-            for _, activity := range resp.Items {
-                d.StreamListItem(ctx, activity)
-                if d.RowsRemaining(ctx) == 0 {
-                    return nil, nil
-                }
-            }
-        }
-        if resp.NextPageToken == "" {
-            break
-        }
-        pageToken = resp.NextPageToken
-        // Ajuster la taille pour la prochaine page selon la limite SQL restante
-        if d.QueryContext.Limit != nil {
-            remaining := d.RowsRemaining(ctx)
-            if remaining > 0 && remaining < apiMaxPageSize {
-                call.MaxResults(int64(remaining))
-            } else {
-                call.MaxResults(apiMaxPageSize)
-            }
-        } else {
-            call.MaxResults(apiMaxPageSize)
-        }
-    }
-
-    return nil, nil
-}
\ No newline at end of file
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsAdminActivity définit la table Steampipe pour l’Admin Reports API, activités “admin”.
+func tableGcpAdminReportsAdminActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_admin_activity",
+		Description: "GCP Admin Reports API - actions de la console d’administration (admin)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsAdminActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "org_unit_name",
+				Description: "Unité organisationnelle concernée par l’action (paramètre ORG_UNIT_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "ORG_UNIT_NAME"),
+			},
+			{
+				Name:        "user_email",
+				Description: "Adresse email de l’utilisateur cible de l’action (paramètre USER_EMAIL)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "USER_EMAIL"),
+			},
+			{
+				Name:        "group_email",
+				Description: "Adresse email du groupe cible de l’action (paramètre GROUP_EMAIL)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "GROUP_EMAIL"),
+			},
+			{
+				Name:        "role_name",
+				Description: "Nom du rôle d’administration attribué ou retiré (paramètre ROLE_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "ROLE_NAME"),
+			},
+			{
+				Name:        "setting_name",
+				Description: "Nom du paramètre modifié (paramètre SETTING_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "SETTING_NAME"),
+			},
+			{
+				Name:        "application_setting",
+				Description: "Application dont le paramètre a été modifié (paramètre APPLICATION_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "APPLICATION_NAME"),
+			},
+			{
+				Name:        "old_value",
+				Description: "Ancienne valeur du paramètre modifié (paramètre OLD_VALUE)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "OLD_VALUE"),
+			},
+			{
+				Name:        "new_value",
+				Description: "Nouvelle valeur du paramètre modifié (paramètre NEW_VALUE)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "NEW_VALUE"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsAdminActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "admin")
+}
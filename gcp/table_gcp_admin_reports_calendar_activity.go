@@ -0,0 +1,84 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsCalendarActivity définit la table Steampipe pour l’Admin Reports API, activités “calendar”.
+func tableGcpAdminReportsCalendarActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_calendar_activity",
+		Description: "GCP Admin Reports API - activité Agenda (création d’événements, partage, abonnements)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsCalendarActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "calendar_id",
+				Description: "Identifiant de l’agenda concerné (paramètre calendar_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "calendar_id"),
+			},
+			{
+				Name:        "event_id",
+				Description: "Identifiant de l’événement d’agenda concerné (paramètre event_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "event_id"),
+			},
+			{
+				Name:        "event_title",
+				Description: "Titre de l’événement d’agenda concerné (paramètre event_title)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "event_title"),
+			},
+			{
+				Name:        "organizer_calendar_id",
+				Description: "Agenda de l’organisateur de l’événement (paramètre organizer_calendar_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "organizer_calendar_id"),
+			},
+			{
+				Name:        "target_calendar_id",
+				Description: "Agenda cible de l’action, par exemple lors d’une invitation (paramètre target_calendar_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "target_calendar_id"),
+			},
+			{
+				Name:        "subscriber_calendar_id",
+				Description: "Agenda abonné lors d’un changement d’abonnement (paramètre subscriber_calendar_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "subscriber_calendar_id"),
+			},
+			{
+				Name:        "grantee_email",
+				Description: "Adresse email du bénéficiaire d’un changement de partage (paramètre grantee_email)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "grantee_email"),
+			},
+			{
+				Name:        "access_level",
+				Description: "Niveau d’accès accordé lors d’un changement de partage (paramètre access_level)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "access_level"),
+			},
+			{
+				Name:        "api_kind",
+				Description: "Origine de l’action : web, mobile ou API (paramètre api_kind)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "api_kind"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsCalendarActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "calendar")
+}
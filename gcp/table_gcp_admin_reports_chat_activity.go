@@ -0,0 +1,78 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsChatActivity définit la table Steampipe pour l’Admin Reports API, activités “chat”.
+func tableGcpAdminReportsChatActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_chat_activity",
+		Description: "GCP Admin Reports API - activité Google Chat (espaces, messages, pièces jointes)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsChatActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "room_id",
+				Description: "Identifiant de l’espace ou de la conversation Chat (paramètre room_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "room_id"),
+			},
+			{
+				Name:        "room_name",
+				Description: "Nom de l’espace Chat (paramètre room_name)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "room_name"),
+			},
+			{
+				Name:        "conversation_type",
+				Description: "Type de conversation : message direct, groupe ou espace (paramètre conversation_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "conversation_type"),
+			},
+			{
+				Name:        "conversation_ownership",
+				Description: "Appartenance de la conversation : interne ou externe (paramètre conversation_ownership)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "conversation_ownership"),
+			},
+			{
+				Name:        "message_id",
+				Description: "Identifiant du message concerné (paramètre message_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "message_id"),
+			},
+			{
+				Name:        "target_users",
+				Description: "Adresses email des participants ciblés par l’action, en JSON (paramètre target_users)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameterList, "target_users"),
+			},
+			{
+				Name:        "attachment_status",
+				Description: "Statut de la pièce jointe du message (paramètre attachment_status)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "attachment_status"),
+			},
+			{
+				Name:        "filename",
+				Description: "Nom du fichier joint (paramètre filename)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "filename"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsChatActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "chat")
+}
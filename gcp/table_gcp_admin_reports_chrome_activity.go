@@ -0,0 +1,126 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsChromeActivity définit la table Steampipe pour l’Admin Reports API, activités “chrome”.
+func tableGcpAdminReportsChromeActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_chrome_activity",
+		Description: "GCP Admin Reports API - activité Chrome et ChromeOS (appareils, navigateurs, stratégies) (chrome)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsChromeActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "device_id",
+				Description: "Identifiant de l’appareil (paramètre DEVICE_ID)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "DEVICE_ID"),
+			},
+			{
+				Name:        "device_name",
+				Description: "Nom de l’appareil (paramètre DEVICE_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "DEVICE_NAME"),
+			},
+			{
+				Name:        "device_platform",
+				Description: "Plateforme de l’appareil (ex: ChromeOS, Windows) (paramètre DEVICE_PLATFORM)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "DEVICE_PLATFORM"),
+			},
+			{
+				Name:        "directory_device_id",
+				Description: "Identifiant de l’appareil dans l’annuaire (paramètre DIRECTORY_DEVICE_ID)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "DIRECTORY_DEVICE_ID"),
+			},
+			{
+				Name:        "virtual_device_id",
+				Description: "Identifiant virtuel de l’appareil pour les navigateurs gérés (paramètre VIRTUAL_DEVICE_ID)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "VIRTUAL_DEVICE_ID"),
+			},
+			{
+				Name:        "device_user",
+				Description: "Utilisateur connecté à l’appareil (paramètre DEVICE_USER)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "DEVICE_USER"),
+			},
+			{
+				Name:        "profile_user_name",
+				Description: "Utilisateur du profil Chrome (paramètre PROFILE_USER_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "PROFILE_USER_NAME"),
+			},
+			{
+				Name:        "browser_version",
+				Description: "Version du navigateur Chrome (paramètre BROWSER_VERSION)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "BROWSER_VERSION"),
+			},
+			{
+				Name:        "client_type",
+				Description: "Type de client Chrome (ex: CHROME_BROWSER, CHROME_OS) (paramètre CLIENT_TYPE)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "CLIENT_TYPE"),
+			},
+			{
+				Name:        "org_unit_name",
+				Description: "Unité organisationnelle de l’appareil ou de l’utilisateur (paramètre ORG_UNIT_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "ORG_UNIT_NAME"),
+			},
+			{
+				Name:        "triggered_rule_name",
+				Description: "Nom de la règle de stratégie déclenchée (paramètre TRIGGERED_RULE_NAME)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "TRIGGERED_RULE_NAME"),
+			},
+			{
+				Name:        "trigger_type",
+				Description: "Type de déclencheur de la règle de stratégie (paramètre TRIGGER_TYPE)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "TRIGGER_TYPE"),
+			},
+			{
+				Name:        "event_reason",
+				Description: "Raison de l’événement, par exemple la stratégie appliquée (paramètre EVENT_REASON)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "EVENT_REASON"),
+			},
+			{
+				Name:        "event_result",
+				Description: "Résultat de l’événement (ex: ALLOWED, BLOCKED, WARNED) (paramètre EVENT_RESULT)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "EVENT_RESULT"),
+			},
+			{
+				Name:        "extension_id",
+				Description: "Identifiant de l’extension concernée (paramètre APP_ID)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "APP_ID"),
+			},
+			{
+				Name:        "url",
+				Description: "URL concernée par l’événement (paramètre URL)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "URL"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsChromeActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "chrome")
+}
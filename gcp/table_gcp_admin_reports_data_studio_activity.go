@@ -0,0 +1,90 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsDataStudioActivity définit la table Steampipe pour l’Admin Reports API, activités “data_studio”.
+func tableGcpAdminReportsDataStudioActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_data_studio_activity",
+		Description: "GCP Admin Reports API - activité Looker Studio (rapports, sources de données, partage) (data_studio)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsDataStudioActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "asset_id",
+				Description: "Identifiant de l’élément Looker Studio (paramètre asset_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "asset_id"),
+			},
+			{
+				Name:        "asset_name",
+				Description: "Nom de l’élément Looker Studio (paramètre asset_name)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "asset_name"),
+			},
+			{
+				Name:        "asset_type",
+				Description: "Type de l’élément : rapport, source de données... (paramètre asset_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "asset_type"),
+			},
+			{
+				Name:        "owner_email",
+				Description: "Adresse email du propriétaire de l’élément (paramètre owner_email)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "owner_email"),
+			},
+			{
+				Name:        "visibility",
+				Description: "Visibilité de l’élément après l’événement (paramètre visibility)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "visibility"),
+			},
+			{
+				Name:        "prior_visibility",
+				Description: "Visibilité de l’élément avant un changement de partage (paramètre prior_visibility)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "prior_visibility"),
+			},
+			{
+				Name:        "target_user_email",
+				Description: "Adresse email de l’utilisateur cible d’un changement de partage (paramètre target_user_email)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "target_user_email"),
+			},
+			{
+				Name:        "target_domain",
+				Description: "Domaine cible d’un changement de partage (paramètre target_domain)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "target_domain"),
+			},
+			{
+				Name:        "old_value",
+				Description: "Ancien rôle de partage (paramètre old_value)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "old_value"),
+			},
+			{
+				Name:        "new_value",
+				Description: "Nouveau rôle de partage (paramètre new_value)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "new_value"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsDataStudioActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "data_studio")
+}
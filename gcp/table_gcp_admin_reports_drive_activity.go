@@ -1,203 +1,120 @@
-package gcp
-
-import (
-	"context"
-	"time"
-
-	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
-	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
-	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
-)
-
-// tableGcpAdminReportsDriveActivity définit la table Steampipe pour l’Admin Reports API, activités “drive”.
-func tableGcpAdminReportsDriveActivity(ctx context.Context) *plugin.Table {
-	return &plugin.Table{
-		Name:        "gcp_admin_reports_drive_activity",
-		Description: "GCP Admin Reports API - activité de connexion (drive)",
-		List: &plugin.ListConfig{
-			Hydrate: listGcpAdminReportsDriveActivities,
-			KeyColumns: plugin.KeyColumnSlice{
-				{Name: "time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
-				{Name: "actor_email", Require: plugin.Optional},
-				{Name: "ip_address", Require: plugin.Optional},
-				{Name: "event_name", Require: plugin.Optional},
-			},
-			Tags: map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
-		},
-		Columns: []*plugin.Column{
-			{
-				Name:        "time",
-				Description: "Horodatage de l'activité (ID.Time) au format RFC3339",
-				Type:        proto.ColumnType_TIMESTAMP,
-				Transform:   transform.FromField("Id.Time"),
-			},
-			{
-				Name:        "actor_email",
-				Description: "Adresse email de l'acteur (Actor.Email)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Actor.Email"),
-			},
-			{
-    			Name:        "event_name",
-    			Description: "Nom de l’événement",
-    			Type:        proto.ColumnType_STRING,
-    			Transform:   transform.FromField("Events").Transform(extractEventNames),
-			},
-			{
-				Name:        "unique_qualifier",
-				Description: "Identifiant unique qualifiant cette activité (ID.UniqueQualifier)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id.UniqueQualifier"),
-			},
-			{
-				Name:        "application_name",
-				Description: "Nom de l’application du rapport (ici toujours 'drive')",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id.ApplicationName"),
-			},
-			{
-				Name:        "actor_profile_id",
-				Description: "Profile ID de l'acteur (Actor.ProfileId)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Actor.ProfileId"),
-			},
-			{
-				Name:        "actor_caller_type",
-				Description: "Type de caller (Actor.CallerType)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Actor.CallerType"),
-			},
-			{
-				Name:        "ip_address",
-				Description: "Adresse IP associée à l’activité (IpAddress)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("IpAddress"),
-			},
-			{
-				Name:        "events",
-				Description: "Liste des événements détaillés (Events) pour cette activité, en JSON",
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("Events"),
-			},
-			{
-				Name:        "title",
-				Description: "Titre de l’activité (Time + Actor Email)",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id.Time").Transform(convertTimeToString).Transform(formatTitleWithActorEmail),
-			},
-			
-		},
-	}
-}
-
-
-
-//// HYDRATE FUNCTIONS
-
-// listGcpAdminReportsDriveActivities liste les activités "drive"
-// Elle gère les qualifiers : time (via StartTime/EndTime), actor_email, ip_address, event_names.
-func listGcpAdminReportsDriveActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-    // Création du service Reports API
-    service, err := ReportsService(ctx, d)
-    if err != nil {
-        plugin.Logger(ctx).Error("gcp_admin_reports_drive_activity.list", "service_error", err)
-        return nil, err
-    }
-
-    call := service.Activities.List("all", "drive")
-
-    // 1. Gestion de la plage temporelle
-    now := time.Now()
-    startTime := now.Add(-180 * 24 * time.Hour)
-    endTime := now
-    if quals := d.Quals["time"]; quals != nil {
-        for _, q := range quals.Quals {
-            if q.Value != nil && q.Value.GetTimestampValue() != nil {
-                t := q.Value.GetTimestampValue().AsTime()
-                switch q.Operator {
-                case "=":
-                    startTime = t
-                    endTime = t
-                case ">":
-                    startTime = t.Add(time.Nanosecond)
-                case ">=":
-                    startTime = t
-                case "<":
-                    endTime = t
-                case "<=":
-                    endTime = t
-                }
-            }
-        }
-    }
-    if !startTime.After(endTime) {
-        call.StartTime(startTime.Format(time.RFC3339))
-        call.EndTime(endTime.Format(time.RFC3339))
-    } else {
-        return nil, nil
-    }
-
-    // 2.
-
-    if quals := d.Quals["event_name"]; quals != nil {
-    for _, q := range quals.Quals {
-        if q.Value != nil {
-            eventName := q.Value.GetStringValue()
-            if eventName != "" {
-                // Utiliser EventName au lieu de Filters 
-                call.EventName(eventName)
-                break
-            }
-        }
-    }
-}
-
-    // 3. Pagination
-    pageToken := ""
-    const apiMaxPageSize = 1000
-    // Déterminer taille de la première page
-    var initialPageSize int64 = apiMaxPageSize
-    if d.QueryContext.Limit != nil {
-        limit := *d.QueryContext.Limit
-        if limit < initialPageSize {
-            initialPageSize = limit
-        }
-    }
-    call.MaxResults(initialPageSize)
-
-    for {
-        if pageToken != "" {
-            call.PageToken(pageToken)
-        }
-        resp, err := call.Do()
-        if err != nil {
-            plugin.Logger(ctx).Error("gcp_admin_reports_drive_activity.list", "api_error", err)
-            return nil, err
-        }
-        if resp.Items != nil {
-            for _, activity := range resp.Items {
-                d.StreamListItem(ctx, activity)
-                if d.RowsRemaining(ctx) == 0 {
-                    return nil, nil
-                }
-            }
-        }
-        if resp.NextPageToken == "" {
-            break
-        }
-        pageToken = resp.NextPageToken
-        // Ajuster la taille pour la prochaine page selon la limite SQL restante
-        if d.QueryContext.Limit != nil {
-            remaining := d.RowsRemaining(ctx)
-            if remaining > 0 && remaining < apiMaxPageSize {
-                call.MaxResults(int64(remaining))
-            } else {
-                call.MaxResults(apiMaxPageSize)
-            }
-        } else {
-            call.MaxResults(apiMaxPageSize)
-        }
-    }
-
-    return nil, nil
-}
\ No newline at end of file
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsDriveActivity définit la table Steampipe pour l’Admin Reports API, activités “drive”.
+func tableGcpAdminReportsDriveActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_drive_activity",
+		Description: "GCP Admin Reports API - activité Drive (partage, téléchargement, permissions)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsDriveActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "doc_id",
+				Description: "Identifiant du document Drive concerné (paramètre doc_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "doc_id"),
+			},
+			{
+				Name:        "doc_title",
+				Description: "Titre du document Drive concerné (paramètre doc_title)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "doc_title"),
+			},
+			{
+				Name:        "doc_type",
+				Description: "Type du document Drive (ex: document, spreadsheet, folder) (paramètre doc_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "doc_type"),
+			},
+			{
+				Name:        "owner",
+				Description: "Adresse email du propriétaire du document (paramètre owner)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "owner"),
+			},
+			{
+				Name:        "owner_is_shared_drive",
+				Description: "Indique si le propriétaire du document est un drive partagé (paramètre owner_is_shared_drive)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsEventBoolParameter, "owner_is_shared_drive"),
+			},
+			{
+				Name:        "shared_drive_id",
+				Description: "Identifiant du drive partagé contenant le document (paramètre shared_drive_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "shared_drive_id"),
+			},
+			{
+				Name:        "visibility",
+				Description: "Visibilité du document après l’événement (ex: private, shared_internally, people_with_link) (paramètre visibility)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "visibility"),
+			},
+			{
+				Name:        "old_visibility",
+				Description: "Visibilité du document avant un changement de partage (paramètre old_visibility)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "old_visibility"),
+			},
+			{
+				Name:        "visibility_change",
+				Description: "Sens du changement de visibilité : external ou internal (paramètre visibility_change)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "visibility_change"),
+			},
+			{
+				Name:        "target_user",
+				Description: "Utilisateur cible d’un changement de permission (paramètre target_user)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "target_user"),
+			},
+			{
+				Name:        "target_domain",
+				Description: "Domaine cible d’un changement de permission (paramètre target_domain)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "target_domain"),
+			},
+			{
+				Name:        "old_value",
+				Description: "Anciennes valeurs d’un changement de permission (paramètre old_value)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameter, "old_value"),
+			},
+			{
+				Name:        "new_value",
+				Description: "Nouvelles valeurs d’un changement de permission (paramètre new_value)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameter, "new_value"),
+			},
+			{
+				Name:        "primary_event",
+				Description: "Indique si l’événement est une action directe de l’utilisateur (paramètre primary_event)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsEventBoolParameter, "primary_event"),
+			},
+			{
+				Name:        "originating_app_id",
+				Description: "Identifiant du projet Google Cloud de l’application à l’origine de l’événement (paramètre originating_app_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "originating_app_id"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsDriveActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "drive")
+}
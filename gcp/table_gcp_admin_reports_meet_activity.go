@@ -0,0 +1,102 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsMeetActivity définit la table Steampipe pour l’Admin Reports API, activités “meet”.
+func tableGcpAdminReportsMeetActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_meet_activity",
+		Description: "GCP Admin Reports API - activité Google Meet (réunions, participants, appareils)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsMeetActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "meeting_code",
+				Description: "Code de la réunion Meet (paramètre meeting_code)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "meeting_code"),
+			},
+			{
+				Name:        "conference_id",
+				Description: "Identifiant unique de la conférence (paramètre conference_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "conference_id"),
+			},
+			{
+				Name:        "calendar_event_id",
+				Description: "Identifiant de l’événement d’agenda associé (paramètre calendar_event_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "calendar_event_id"),
+			},
+			{
+				Name:        "organizer_email",
+				Description: "Adresse email de l’organisateur de la réunion (paramètre organizer_email)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "organizer_email"),
+			},
+			{
+				Name:        "participant_email",
+				Description: "Adresse email du participant (paramètre identifier)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "identifier"),
+			},
+			{
+				Name:        "participant_identifier_type",
+				Description: "Type d’identifiant du participant (ex: email_address, phone_number) (paramètre identifier_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "identifier_type"),
+			},
+			{
+				Name:        "display_name",
+				Description: "Nom affiché du participant (paramètre display_name)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "display_name"),
+			},
+			{
+				Name:        "is_external",
+				Description: "Indique si le participant est externe au domaine (paramètre is_external)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsEventBoolParameter, "is_external"),
+			},
+			{
+				Name:        "duration_seconds",
+				Description: "Durée de participation en secondes (paramètre duration_seconds)",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(adminReportsEventIntParameter, "duration_seconds"),
+			},
+			{
+				Name:        "device_type",
+				Description: "Type d’appareil utilisé par le participant (paramètre device_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "device_type"),
+			},
+			{
+				Name:        "product_type",
+				Description: "Produit utilisé pour rejoindre la réunion (paramètre product_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "product_type"),
+			},
+			{
+				Name:        "location_country",
+				Description: "Pays depuis lequel le participant a rejoint la réunion (paramètre location_country)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "location_country"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsMeetActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "meet")
+}
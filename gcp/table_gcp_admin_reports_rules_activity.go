@@ -0,0 +1,114 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// tableGcpAdminReportsRulesActivity définit la table Steampipe pour l’Admin Reports API, activités “rules”.
+func tableGcpAdminReportsRulesActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_rules_activity",
+		Description: "GCP Admin Reports API - déclenchements de règles (DLP, alertes) (rules)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsRulesActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "rule_id",
+				Description: "Identifiant de la règle déclenchée (paramètre rule_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "rule_id"),
+			},
+			{
+				Name:        "rule_name",
+				Description: "Nom de la règle déclenchée (paramètre rule_name)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "rule_name"),
+			},
+			{
+				Name:        "rule_type",
+				Description: "Type de règle (ex: DLP, activité) (paramètre rule_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "rule_type"),
+			},
+			{
+				Name:        "severity",
+				Description: "Sévérité associée à la règle (paramètre severity)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "severity"),
+			},
+			{
+				Name:        "trigger_type",
+				Description: "Déclencheur ayant évalué la règle (ex: DRIVE_SHARE, CHAT_MESSAGE_SENT) (paramètre matched_trigger)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "matched_trigger"),
+			},
+			{
+				Name:        "data_source",
+				Description: "Application source des données analysées (paramètre data_source)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "data_source"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "Identifiant de la ressource ayant déclenché la règle (paramètre resource_id)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "resource_id"),
+			},
+			{
+				Name:        "resource_title",
+				Description: "Titre de la ressource ayant déclenché la règle (paramètre resource_title)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "resource_title"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "Type de la ressource ayant déclenché la règle (paramètre resource_type)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "resource_type"),
+			},
+			{
+				Name:        "resource_owner_email",
+				Description: "Adresse email du propriétaire de la ressource (paramètre resource_owner_email)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(adminReportsEventParameter, "resource_owner_email"),
+			},
+			{
+				Name:        "matched_detectors",
+				Description: "Détecteurs ayant correspondu au contenu, en JSON (paramètre matched_detectors)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameter, "matched_detectors"),
+			},
+			{
+				Name:        "triggered_actions",
+				Description: "Actions déclenchées par la règle, en JSON (paramètre triggered_actions)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameter, "triggered_actions"),
+			},
+			{
+				Name:        "suppressed_actions",
+				Description: "Actions supprimées lors du déclenchement, en JSON (paramètre suppressed_actions)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(adminReportsEventParameter, "suppressed_actions"),
+			},
+			{
+				Name:        "has_alert",
+				Description: "Indique si la règle a généré une alerte (paramètre has_alert)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsEventBoolParameter, "has_alert"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsRulesActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "rules")
+}
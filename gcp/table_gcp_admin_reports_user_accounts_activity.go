@@ -0,0 +1,115 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	adminreports "google.golang.org/api/admin/reports/v1"
+)
+
+// tableGcpAdminReportsUserAccountsActivity définit la table Steampipe pour l’Admin Reports API, activités “user_accounts”.
+func tableGcpAdminReportsUserAccountsActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_user_accounts_activity",
+		Description: "GCP Admin Reports API - activité des comptes utilisateurs (mot de passe, validation en deux étapes, récupération) (user_accounts)",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAdminReportsUserAccountsActivities,
+			KeyColumns: adminReportsActivityKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: adminReportsActivityColumns([]*plugin.Column{
+			{
+				Name:        "event_category",
+				Description: "Catégorie de l’événement : password, 2sv, recovery_info, passkey, login_challenge ou other",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Events").Transform(userAccountsEventCategory),
+			},
+			{
+				Name:        "password_changed",
+				Description: "Indique si l’activité correspond à un changement de mot de passe (événement password_edit)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(userAccountsHasEvent, "password_edit"),
+			},
+			{
+				Name:        "two_step_verification_enrolled",
+				Description: "Indique si l’utilisateur s’est inscrit à la validation en deux étapes (événement 2sv_enroll)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(userAccountsHasEvent, "2sv_enroll"),
+			},
+			{
+				Name:        "two_step_verification_disabled",
+				Description: "Indique si l’utilisateur a désactivé la validation en deux étapes (événement 2sv_disable)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(userAccountsHasEvent, "2sv_disable"),
+			},
+			{
+				Name:        "recovery_email_changed",
+				Description: "Indique si l’email de récupération a été modifié (événement recovery_email_edit)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(userAccountsHasEvent, "recovery_email_edit"),
+			},
+			{
+				Name:        "recovery_phone_changed",
+				Description: "Indique si le téléphone de récupération a été modifié (événement recovery_phone_edit)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(userAccountsHasEvent, "recovery_phone_edit"),
+			},
+			{
+				Name:        "recovery_secret_qa_changed",
+				Description: "Indique si la question secrète de récupération a été modifiée (événement recovery_secret_qa_edit)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(userAccountsHasEvent, "recovery_secret_qa_edit"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAdminReportsUserAccountsActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listAdminReportsActivities(ctx, d, "user_accounts")
+}
+
+//// TRANSFORM FUNCTIONS
+
+// userAccountsHasEvent indique si l'activité contient un événement dont le nom est passé en d.Param.
+func userAccountsHasEvent(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	activity, ok := d.HydrateItem.(*adminreports.Activity)
+	if !ok {
+		return nil, nil
+	}
+	name := d.Param.(string)
+
+	for _, event := range activity.Events {
+		if event != nil && event.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// userAccountsEventCategory regroupe le premier événement de l'activité par grande catégorie.
+func userAccountsEventCategory(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	events, ok := d.Value.([]*adminreports.ActivityEvents)
+	if !ok || len(events) == 0 || events[0] == nil {
+		return nil, nil
+	}
+
+	name := events[0].Name
+	switch {
+	case strings.HasPrefix(name, "password_"):
+		return "password", nil
+	case strings.HasPrefix(name, "2sv_"):
+		return "2sv", nil
+	case strings.HasPrefix(name, "recovery_"):
+		return "recovery_info", nil
+	case strings.HasPrefix(name, "passkey_"):
+		return "passkey", nil
+	case strings.HasPrefix(name, "login_challenge"):
+		return "login_challenge", nil
+	}
+	return "other", nil
+}
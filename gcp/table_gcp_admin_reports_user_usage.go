@@ -0,0 +1,205 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	adminreports "google.golang.org/api/admin/reports/v1"
+)
+
+// tableGcpAdminReportsUserUsage définit la table Steampipe pour les rapports d’utilisation par utilisateur (userUsageReport).
+func tableGcpAdminReportsUserUsage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_admin_reports_user_usage",
+		Description: "GCP Admin Reports API - rapports d’utilisation quotidiens par utilisateur Google Workspace",
+		List: &plugin.ListConfig{
+			Hydrate: listGcpAdminReportsUserUsages,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "user_email", Require: plugin.Optional},
+				{Name: "parameter_filter", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "reports", "action": "userUsageReport.get"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "date",
+				Description: "Date du rapport d’utilisation (sans qualifier, les 7 derniers jours sont retournés)",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.From(adminReportsUsageDate),
+			},
+			{
+				Name:        "user_email",
+				Description: "Adresse email de l’utilisateur (Entity.UserEmail)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entity.UserEmail"),
+			},
+			{
+				Name:        "profile_id",
+				Description: "Profile ID de l’utilisateur (Entity.ProfileId)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entity.ProfileId"),
+			},
+			{
+				Name:        "customer_id",
+				Description: "Identifiant du client Google Workspace (Entity.CustomerId)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entity.CustomerId"),
+			},
+			{
+				Name:        "parameter_filter",
+				Description: "Liste de paramètres séparés par des virgules transmise à l’API (ex: 'accounts:last_login_time,accounts:is_2sv_enrolled')",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("parameter_filter"),
+			},
+			{
+				Name:        "parameters",
+				Description: "Ensemble des paramètres du rapport en JSON (nom → valeur)",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(adminReportsUsageParameters),
+			},
+
+			// Paramètres d’utilisation les plus courants
+			{
+				Name:        "used_quota_in_mb",
+				Description: "Stockage utilisé en Mo, tous services confondus (paramètre accounts:used_quota_in_mb)",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(adminReportsUsageIntParameter, "accounts:used_quota_in_mb"),
+			},
+			{
+				Name:        "total_quota_in_mb",
+				Description: "Quota de stockage total en Mo (paramètre accounts:total_quota_in_mb)",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(adminReportsUsageIntParameter, "accounts:total_quota_in_mb"),
+			},
+			{
+				Name:        "last_login_time",
+				Description: "Date de dernière connexion de l’utilisateur (paramètre accounts:last_login_time)",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(adminReportsUsageParameter, "accounts:last_login_time"),
+			},
+			{
+				Name:        "is_2sv_enrolled",
+				Description: "Indique si l’utilisateur est inscrit à la validation en deux étapes (paramètre accounts:is_2sv_enrolled)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsUsageParameter, "accounts:is_2sv_enrolled"),
+			},
+			{
+				Name:        "is_2sv_enforced",
+				Description: "Indique si la validation en deux étapes est imposée à l’utilisateur (paramètre accounts:is_2sv_enforced)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsUsageParameter, "accounts:is_2sv_enforced"),
+			},
+			{
+				Name:        "is_super_admin",
+				Description: "Indique si l’utilisateur est super administrateur (paramètre accounts:is_super_admin)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsUsageParameter, "accounts:is_super_admin"),
+			},
+			{
+				Name:        "is_disabled",
+				Description: "Indique si le compte de l’utilisateur est suspendu (paramètre accounts:is_disabled)",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(adminReportsUsageParameter, "accounts:is_disabled"),
+			},
+			{
+				Name:        "gmail_last_interaction_time",
+				Description: "Date de dernière interaction avec Gmail (paramètre gmail:last_interaction_time)",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(adminReportsUsageParameter, "gmail:last_interaction_time"),
+			},
+			{
+				Name:        "drive_last_active_usage_time",
+				Description: "Date de dernière utilisation active de Drive (paramètre drive:last_active_usage_time)",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(adminReportsUsageParameter, "drive:last_active_usage_time"),
+			},
+			{
+				Name:        "title",
+				Description: "Titre du rapport (Date + User Email)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(userUsageReportTitle),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// listGcpAdminReportsUserUsages interroge UserUsageReport.Get pour chaque date demandée.
+// Elle gère les qualifiers : date, user_email (via userKey) et parameter_filter.
+func listGcpAdminReportsUserUsages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	service, err := ReportsUsageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_reports_user_usage.listGcpAdminReportsUserUsages", "service_error", err)
+		return nil, err
+	}
+
+	userKey := "all"
+	if userEmail := d.EqualsQualString("user_email"); userEmail != "" {
+		userKey = userEmail
+	}
+	parameters := d.EqualsQualString("parameter_filter")
+
+	// Max limit is set as per documentation
+	pageSize := int64(1000)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	dates, err := adminReportsUsageDates(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_admin_reports_user_usage.listGcpAdminReportsUserUsages", "date_error", err)
+		return nil, err
+	}
+
+	for _, date := range dates {
+		call := service.UserUsageReport.Get(userKey, date).MaxResults(pageSize)
+		if parameters != "" {
+			call.Parameters(parameters)
+		}
+
+		err := call.Pages(ctx, func(page *adminreports.UsageReports) error {
+			for _, report := range page.UsageReports {
+				d.StreamListItem(ctx, report)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			// Les rapports ne sont disponibles qu’avec quelques jours de décalage
+			if isAdminReportsUsageDataNotAvailable(err) {
+				plugin.Logger(ctx).Debug("gcp_admin_reports_user_usage.listGcpAdminReportsUserUsages", "date_not_available", date)
+				continue
+			}
+			plugin.Logger(ctx).Error("gcp_admin_reports_user_usage.listGcpAdminReportsUserUsages", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func userUsageReportTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	report, ok := d.HydrateItem.(*adminreports.UsageReport)
+	if !ok {
+		return nil, nil
+	}
+	if report.Entity == nil || report.Entity.UserEmail == "" {
+		return report.Date, nil
+	}
+	return report.Date + " - " + report.Entity.UserEmail, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/appengine/v1"
 )
 
 //// TABLE DEFINITION
@@ -20,6 +21,12 @@ func tableGcpAppEngineApplication(ctx context.Context) *plugin.Table {
 			Hydrate: getAppEngineApplication,
 			Tags:    map[string]string{"service": "appengine", "action": "applications.get"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAppEngineApplicationIapIamPolicy,
+				Tags: map[string]string{"service": "iap", "action": "v1.getIamPolicy"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "id",
@@ -76,6 +83,12 @@ func tableGcpAppEngineApplication(ctx context.Context) *plugin.Table {
 				Description: "Serving status of this application.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "iap_enabled",
+				Description: "Whether the serving infrastructure will authenticate and authorize all incoming requests with Identity-Aware Proxy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Iap.Enabled"),
+			},
 			{
 				Name:        "dispatch_rules",
 				Description: "HTTP path dispatch rules for requests to the application that do not explicitly target a service or version.",
@@ -91,6 +104,13 @@ func tableGcpAppEngineApplication(ctx context.Context) *plugin.Table {
 				Description: "Identity-Aware Proxy.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "iap_iam_policy",
+				Description: "The IAM policy of the Identity-Aware Proxy protecting the application, which specifies who can access it through IAP. Only available when IAP is enabled on the application.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppEngineApplicationIapIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -156,3 +176,28 @@ func getAppEngineApplication(ctx context.Context, d *plugin.QueryData, h *plugin
 
 	return resp, nil
 }
+
+//// HYDRATE FUNCTIONS
+
+func getAppEngineApplicationIapIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	application := h.Item.(*appengine.Application)
+
+	// The IAP IAM policy is only available when the application is protected by IAP
+	if application.Iap == nil || !application.Iap.Enabled {
+		return nil, nil
+	}
+
+	projectNumber, err := getIapProjectNumber(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_application.getAppEngineApplicationIapIamPolicy", "project_error", err)
+		return nil, err
+	}
+
+	resp, err := getIapIamPolicy(ctx, d, iapAppEngineResourceName(projectNumber.(string), application))
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_application.getAppEngineApplicationIapIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
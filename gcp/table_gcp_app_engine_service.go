@@ -0,0 +1,244 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/appengine/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAppEngineService(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_app_engine_service",
+		Description: "GCP App Engine Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getAppEngineService,
+			Tags:       map[string]string{"service": "appengine", "action": "services.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppEngineServices,
+			Tags:    map[string]string{"service": "appengine", "action": "services.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "Relative name of the service within the application. Example: default.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "Full path to the Service resource in the API. Example: apps/myapp/services/default.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ingress_traffic_allowed",
+				Description: "The ingress settings for the service (INGRESS_TRAFFIC_ALLOWED_ALL, INGRESS_TRAFFIC_ALLOWED_INTERNAL_ONLY, INGRESS_TRAFFIC_ALLOWED_INTERNAL_AND_LB).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkSettings.IngressTrafficAllowed"),
+			},
+			{
+				Name:        "split_shard_by",
+				Description: "Mechanism used to determine which version a request is sent to (COOKIE, IP or RANDOM).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Split.ShardBy"),
+			},
+			{
+				Name:        "split_allocations",
+				Description: "Mapping from version IDs within the service to fractional (0.000, 1] allocations of traffic for that version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Split.Allocations"),
+			},
+			{
+				Name:        "labels",
+				Description: "A set of labels to apply to this service.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(appEngineResourceAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAppEngineApplicationLocation,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(appEngineResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppEngineServices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := AppEngineService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_service.listAppEngineServices", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Apps.Services.List(project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *appengine.ListServicesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Services {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_service.listAppEngineServices", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppEngineService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Empty Check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AppEngineService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_service.getAppEngineService", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Apps.Services.Get(project, id).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_service.getAppEngineService", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// There is only one application per project, its location is shared by all
+// services and versions.
+func getAppEngineApplicationLocation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	application, err := getAppEngineApplicationMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return application.(*appengine.Application).LocationId, nil
+}
+
+var getAppEngineApplicationMemoized = plugin.HydrateFunc(getAppEngineApplicationUncached).Memoize(memoize.WithCacheKeyFunction(getAppEngineApplicationCacheKey))
+
+func getAppEngineApplicationCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheKey := "getAppEngineApplication"
+	return cacheKey, nil
+}
+
+func getAppEngineApplicationUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := AppEngineService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Apps.Get(project).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// App Engine resource names have the form apps/{project}/services/{service}[/versions/{version}]
+func appEngineResourceProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 2 {
+		return nil, nil
+	}
+	return parts[1], nil
+}
+
+func appEngineResourceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://appengine.googleapis.com/" + name}, nil
+}
@@ -0,0 +1,341 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/appengine/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAppEngineVersion(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_app_engine_version",
+		Description: "GCP App Engine Version",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"service_id", "id"}),
+			Hydrate:    getAppEngineVersion,
+			Tags:       map[string]string{"service": "appengine", "action": "versions.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppEngineServices,
+			Hydrate:       listAppEngineVersions,
+			Tags:          map[string]string{"service": "appengine", "action": "versions.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "service_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAppEngineVersionTrafficAllocation,
+				Tags: map[string]string{"service": "appengine", "action": "services.get"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "Relative name of the version within the service. Example: v1.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "Full path to the Version resource in the API. Example: apps/myapp/services/default/versions/v1.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_id",
+				Description: "Relative name of the service the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(appEngineVersionServiceID),
+			},
+			{
+				Name:        "serving_status",
+				Description: "Current serving status of this version. Only the versions with a SERVING status create instances and can be billed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "runtime",
+				Description: "Desired runtime. Example: python27.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "runtime_channel",
+				Description: "The channel of the runtime to use. Only available for some runtimes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "env",
+				Description: "App Engine execution environment for this version. Defaults to standard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_class",
+				Description: "Instance class that is used to run this version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scaling_type",
+				Description: "The scaling type configured for this version (AUTOMATIC, BASIC or MANUAL).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(appEngineVersionScalingType),
+			},
+			{
+				Name:        "traffic_allocation",
+				Description: "Fraction of the service traffic allocated to this version. Zero if the version receives no traffic.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getAppEngineVersionTrafficAllocation,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "service_account",
+				Description: "The identity that the deployed version will run as.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time that this version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "created_by",
+				Description: "Email address of the user who created this version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disk_usage_bytes",
+				Description: "Total size in bytes of all the files that are included in this version and currently hosted on the App Engine disk.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "version_url",
+				Description: "Serving URL for this version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_access_connector",
+				Description: "Full Serverless VPC Access Connector name used by this version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VpcAccessConnector.Name"),
+			},
+			{
+				Name:        "automatic_scaling",
+				Description: "Automatic scaling is based on request rate, response latencies, and other application metrics.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "basic_scaling",
+				Description: "A service with basic scaling will create an instance when the application receives a request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "manual_scaling",
+				Description: "A service with manual scaling runs continuously, allowing you to perform complex initialization and rely on the state of its memory over time.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "inbound_services",
+				Description: "Before an application can receive email or XMPP messages, the application must be configured to enable the service.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network",
+				Description: "Extra network settings. Only applicable in the App Engine flexible environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resources",
+				Description: "Machine resources for this version. Only applicable in the App Engine flexible environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "handlers",
+				Description: "An ordered list of URL-matching patterns that should be applied to incoming requests.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(appEngineResourceAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAppEngineApplicationLocation,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(appEngineResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppEngineVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	appService := h.Item.(*appengine.Service)
+
+	// Minimize API call as per given service
+	serviceID := d.EqualsQualString("service_id")
+	if serviceID != "" && serviceID != appService.Id {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AppEngineService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_version.listAppEngineVersions", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Apps.Services.Versions.List(project, appService.Id).View("FULL").PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *appengine.ListVersionsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Versions {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_version.listAppEngineVersions", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppEngineVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceID := d.EqualsQualString("service_id")
+	id := d.EqualsQualString("id")
+
+	// Empty Check
+	if serviceID == "" || id == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AppEngineService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_version.getAppEngineVersion", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Apps.Services.Versions.Get(project, serviceID, id).View("FULL").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_version.getAppEngineVersion", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// The traffic split is defined on the parent service
+func getAppEngineVersionTrafficAllocation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	version := h.Item.(*appengine.Version)
+
+	// Name is of the form apps/{project}/services/{service}/versions/{version}
+	parts := strings.Split(version.Name, "/")
+	if len(parts) < 6 {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AppEngineService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_version.getAppEngineVersionTrafficAllocation", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Apps.Services.Get(parts[1], parts[3]).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_app_engine_version.getAppEngineVersionTrafficAllocation", "api_error", err)
+		return nil, err
+	}
+
+	if resp.Split == nil {
+		return 0.0, nil
+	}
+	return resp.Split.Allocations[version.Id], nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func appEngineVersionServiceID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 4 {
+		return nil, nil
+	}
+	return parts[3], nil
+}
+
+func appEngineVersionScalingType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version := d.HydrateItem.(*appengine.Version)
+
+	switch {
+	case version.AutomaticScaling != nil:
+		return "AUTOMATIC", nil
+	case version.BasicScaling != nil:
+		return "BASIC", nil
+	case version.ManualScaling != nil:
+		return "MANUAL", nil
+	}
+	return nil, nil
+}
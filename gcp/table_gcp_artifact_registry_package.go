@@ -0,0 +1,153 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/artifactregistry/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpArtifactRegistryPackage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_artifact_registry_package",
+		Description: "GCP Artifact Registry Package",
+		List: &plugin.ListConfig{
+			ParentHydrate: listArtifactRegistryRepositories,
+			Hydrate:       listArtifactRegistryPackages,
+			Tags:          map[string]string{"service": "artifactregistry", "action": "packages.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "repository_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildArtifactRegistryLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the package, for example the image name of a Docker package.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 7),
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository the package belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the package was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time when the package was last updated. This includes publishing a new version of the package.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the package.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Client specified annotations.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName", "Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "artifactregistry.googleapis.com"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listArtifactRegistryPackages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(*artifactregistry.Repository)
+
+	// Minimize the API call with the given repository name
+	repositoryName := d.EqualsQualString("repository_name")
+	if repositoryName != "" && repositoryName != getLastPathElement(repository.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ArtifactRegistryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_artifact_registry_package.listArtifactRegistryPackages", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Locations.Repositories.Packages.List(repository.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *artifactregistry.ListPackagesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Packages {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_artifact_registry_package.listArtifactRegistryPackages", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
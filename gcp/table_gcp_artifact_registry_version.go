@@ -0,0 +1,197 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/artifactregistry/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpArtifactRegistryVersion(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_artifact_registry_version",
+		Description: "GCP Artifact Registry Version",
+		List: &plugin.ListConfig{
+			ParentHydrate: listArtifactRegistryRepositories,
+			Hydrate:       listArtifactRegistryVersions,
+			Tags:          map[string]string{"service": "artifactregistry", "action": "versions.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "repository_name", Require: plugin.Optional},
+				{Name: "package_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildArtifactRegistryLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the version, for example the digest of a Docker image.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "package_name",
+				Description: "The name of the package the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 7),
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the version, as specified in its metadata.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time when the version was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "related_tags",
+				Description: "A list of the tags pointing to the version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata",
+				Description: "Repository-specific metadata stored against the version, such as the image size and build time of Docker images.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "Client specified annotations.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "artifactregistry.googleapis.com"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listArtifactRegistryVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(*artifactregistry.Repository)
+
+	// Minimize the API call with the given repository name
+	repositoryName := d.EqualsQualString("repository_name")
+	if repositoryName != "" && repositoryName != getLastPathElement(repository.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ArtifactRegistryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_artifact_registry_version.listArtifactRegistryVersions", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// List the packages of the repository, unless the package name is given
+	packageNames := []string{}
+	if packageName := d.EqualsQualString("package_name"); packageName != "" {
+		packageNames = append(packageNames, repository.Name+"/packages/"+packageName)
+	} else {
+		resp := service.Projects.Locations.Repositories.Packages.List(repository.Name).PageSize(1000)
+		if err := resp.Pages(ctx, func(page *artifactregistry.ListPackagesResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, item := range page.Packages {
+				packageNames = append(packageNames, item.Name)
+			}
+			return nil
+		}); err != nil {
+			plugin.Logger(ctx).Error("gcp_artifact_registry_version.listArtifactRegistryVersions", "api_error", err)
+			return nil, err
+		}
+	}
+
+	for _, packageName := range packageNames {
+		// The FULL view includes the tags pointing to each version
+		resp := service.Projects.Locations.Repositories.Packages.Versions.List(packageName).View("FULL").PageSize(*pageSize)
+		if err := resp.Pages(ctx, func(page *artifactregistry.ListVersionsResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, version := range page.Versions {
+				d.StreamListItem(ctx, version)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			plugin.Logger(ctx).Error("gcp_artifact_registry_version.listArtifactRegistryVersions", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,28 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAuditLogAdminActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_audit_log_admin_activity",
+		Description: "GCP Cloud Audit Logs Admin Activity",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAuditLogAdminActivities,
+			KeyColumns: auditLogKeyColumns(),
+			Tags:       map[string]string{"service": "logging", "action": "logEntries.list"},
+		},
+		Columns: auditLogColumns([]*plugin.Column{}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAuditLogAdminActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listAuditLogEntries(ctx, d, h, "activity")
+}
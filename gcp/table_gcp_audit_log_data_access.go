@@ -0,0 +1,37 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAuditLogDataAccess(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_audit_log_data_access",
+		Description: "GCP Cloud Audit Logs Data Access",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAuditLogDataAccesses,
+			KeyColumns: auditLogKeyColumns(),
+			Tags:       map[string]string{"service": "logging", "action": "logEntries.list"},
+		},
+		Columns: auditLogColumns([]*plugin.Column{
+			{
+				Name:        "num_response_items",
+				Description: "The number of items returned from a List or Query API method, if applicable.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AuditLog.NumResponseItems"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAuditLogDataAccesses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listAuditLogEntries(ctx, d, h, "data_access")
+}
@@ -0,0 +1,43 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAuditLogPolicyDenied(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_audit_log_policy_denied",
+		Description: "GCP Cloud Audit Logs Policy Denied",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAuditLogPolicyDenials,
+			KeyColumns: auditLogKeyColumns(),
+			Tags:       map[string]string{"service": "logging", "action": "logEntries.list"},
+		},
+		Columns: auditLogColumns([]*plugin.Column{
+			{
+				Name:        "violation_reason",
+				Description: "The reason the request was denied by VPC Service Controls, for example NO_MATCHING_ACCESS_LEVEL or RESOURCES_NOT_IN_SAME_SERVICE_PERIMETER.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(auditLogMetadataField, "violationReason"),
+			},
+			{
+				Name:        "security_policy_info",
+				Description: "Information about the security policy that denied the request, such as the name of the service perimeter.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(auditLogMetadataField, "securityPolicyInfo"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAuditLogPolicyDenials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listAuditLogEntries(ctx, d, h, "policy")
+}
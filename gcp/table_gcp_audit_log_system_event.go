@@ -0,0 +1,28 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpAuditLogSystemEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_audit_log_system_event",
+		Description: "GCP Cloud Audit Logs System Event",
+		List: &plugin.ListConfig{
+			Hydrate:    listGcpAuditLogSystemEvents,
+			KeyColumns: auditLogKeyColumns(),
+			Tags:       map[string]string{"service": "logging", "action": "logEntries.list"},
+		},
+		Columns: auditLogColumns([]*plugin.Column{}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpAuditLogSystemEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listAuditLogEntries(ctx, d, h, "system_event")
+}
@@ -0,0 +1,216 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/bigqueryreservation/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBigQueryCapacityCommitment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_bigquery_capacity_commitment",
+		Description: "GCP BigQuery Capacity Commitment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getBigQueryCapacityCommitment,
+			Tags:       map[string]string{"service": "bigqueryreservation", "action": "capacityCommitments.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBigQueryCapacityCommitments,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "bigqueryreservation", "action": "capacityCommitments.list"},
+		},
+		GetMatrixItemFunc: BuildBigQueryReservationLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the capacity commitment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "slot_count",
+				Description: "Number of slots in this commitment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "plan",
+				Description: "Capacity commitment commitment plan (FLEX, MONTHLY, ANNUAL, THREE_YEAR...).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "renewal_plan",
+				Description: "The plan this capacity commitment is converted to after commitment_end_time passes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "State of the commitment (PENDING, ACTIVE or FAILED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edition",
+				Description: "Edition of the capacity commitment (STANDARD, ENTERPRISE or ENTERPRISE_PLUS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_flat_rate",
+				Description: "If true, the commitment is a flat-rate commitment, otherwise it's an edition commitment.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "commitment_start_time",
+				Description: "The start of the current commitment period.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CommitmentStartTime").NullIfZero(),
+			},
+			{
+				Name:        "commitment_end_time",
+				Description: "The end of the current commitment period.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CommitmentEndTime").NullIfZero(),
+			},
+			{
+				Name:        "multi_region_auxiliary",
+				Description: "Applicable only for commitments located within one of the BigQuery multi-regions (US or EU). If true, this commitment is placed in the organization's secondary region.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "failure_status",
+				Description: "For FAILED commitment plan, provides the reason of failure.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBigQueryCapacityCommitments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// Create Service Connection
+	service, err := BigQueryReservationService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_capacity_commitment.listBigQueryCapacityCommitments", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.CapacityCommitments.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *bigqueryreservation.ListCapacityCommitmentsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.CapacityCommitments {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_capacity_commitment.listBigQueryCapacityCommitments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBigQueryCapacityCommitment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BigQueryReservationService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_capacity_commitment.getBigQueryCapacityCommitment", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.CapacityCommitments.Get("projects/" + project + "/locations/" + location + "/capacityCommitments/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_capacity_commitment.getBigQueryCapacityCommitment", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
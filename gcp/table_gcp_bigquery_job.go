@@ -24,6 +24,17 @@ func tableGcpBigQueryJob(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listBigQueryJobs,
 			Tags:    map[string]string{"service": "bigquery", "action": "jobs.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "creation_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<=", "="},
+				},
+				{
+					Name:    "state",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -108,6 +119,31 @@ func tableGcpBigQueryJob(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("Statistics.TotalBytesProcessed"),
 			},
+			{
+				Name:        "job_type",
+				Description: "The type of the job (QUERY, LOAD, EXTRACT, COPY or UNKNOWN).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBigQueryJob,
+				Transform:   transform.FromField("Configuration.JobType"),
+			},
+			{
+				Name:        "statement_type",
+				Description: "The type of query statement, if valid.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statistics.Query.StatementType"),
+			},
+			{
+				Name:        "total_bytes_billed",
+				Description: "Total bytes billed for the job.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Statistics.Query.TotalBytesBilled"),
+			},
+			{
+				Name:        "cache_hit",
+				Description: "Whether the query result was fetched from the query cache.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Statistics.Query.CacheHit"),
+			},
 			{
 				Name:        "total_slot_ms",
 				Description: "Slot-milliseconds for the job.",
@@ -159,6 +195,11 @@ func tableGcpBigQueryJob(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Statistics.Load"),
 			},
+			{
+				Name:        "statistics",
+				Description: "Information about the job, including starting time and ending time of the job.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "query",
 				Description: "Statistics for a query job.",
@@ -270,6 +311,26 @@ func listBigQueryJobs(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	project := projectId.(string)
 
 	resp := service.Jobs.List(project).MaxResults(*pageSize).AllUsers(true)
+
+	// Filter jobs by creation time, in milliseconds since the epoch
+	if d.Quals["creation_time"] != nil {
+		for _, q := range d.Quals["creation_time"].Quals {
+			creationTime := uint64(q.Value.GetTimestampValue().AsTime().UnixMilli())
+			switch q.Operator {
+			case ">", ">=":
+				resp.MinCreationTime(creationTime)
+			case "<", "<=":
+				resp.MaxCreationTime(creationTime)
+			case "=":
+				resp.MinCreationTime(creationTime).MaxCreationTime(creationTime)
+			}
+		}
+	}
+
+	// The API expects the state in lower case (done, pending or running)
+	if state := d.EqualsQualString("state"); state != "" {
+		resp.StateFilter(strings.ToLower(state))
+	}
 	if err := resp.Pages(ctx, func(page *bigquery.JobList) error {
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
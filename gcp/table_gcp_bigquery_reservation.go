@@ -0,0 +1,262 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/bigqueryreservation/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBigQueryReservation(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_bigquery_reservation",
+		Description: "GCP BigQuery Reservation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getBigQueryReservation,
+			Tags:       map[string]string{"service": "bigqueryreservation", "action": "reservations.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBigQueryReservations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "bigqueryreservation", "action": "reservations.list"},
+		},
+		GetMatrixItemFunc: BuildBigQueryReservationLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "slot_capacity",
+				Description: "Baseline slots available to this reservation.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "edition",
+				Description: "Edition of the reservation (STANDARD, ENTERPRISE or ENTERPRISE_PLUS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ignore_idle_slots",
+				Description: "If false, any query or pipeline job using this reservation will use idle slots from other reservations within the same admin project.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "concurrency",
+				Description: "Job concurrency target which sets a soft upper bound on the number of jobs that can run concurrently in this reservation. Zero means the target is computed automatically.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "autoscale_max_slots",
+				Description: "Number of slots to be scaled when needed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Autoscale.MaxSlots"),
+			},
+			{
+				Name:        "autoscale_current_slots",
+				Description: "The slot capacity added to this reservation when autoscale happens.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Autoscale.CurrentSlots"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "Creation time of the reservation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Last update time of the reservation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "primary_location",
+				Description: "The current location of the reservation's primary replica.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secondary_location",
+				Description: "The location where the reservation was originally created, for a failover reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "multi_region_auxiliary",
+				Description: "Applicable only for reservations located within one of the BigQuery multi-regions (US or EU). If true, this reservation is placed in the organization's secondary region.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels associated with this reservation.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBigQueryReservations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// Create Service Connection
+	service, err := BigQueryReservationService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_reservation.listBigQueryReservations", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Reservations.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *bigqueryreservation.ListReservationsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Reservations {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_reservation.listBigQueryReservations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBigQueryReservation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BigQueryReservationService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_reservation.getBigQueryReservation", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Reservations.Get("projects/" + project + "/locations/" + location + "/reservations/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_reservation.getBigQueryReservation", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Reservation API resource names have the form projects/{project}/locations/{location}/...
+func bigQueryReservationProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 4 {
+		return nil, nil
+	}
+	return parts[1], nil
+}
+
+func bigQueryReservationLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 4 {
+		return nil, nil
+	}
+	return parts[3], nil
+}
+
+func bigQueryReservationAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://bigqueryreservation.googleapis.com/" + name}, nil
+}
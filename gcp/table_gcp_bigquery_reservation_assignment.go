@@ -0,0 +1,189 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/bigqueryreservation/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBigQueryReservationAssignment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_bigquery_reservation_assignment",
+		Description: "GCP BigQuery Reservation Assignment",
+		List: &plugin.ListConfig{
+			Hydrate: listBigQueryReservationAssignments,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "reservation_name",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "bigqueryreservation", "action": "reservations.assignments.list"},
+		},
+		GetMatrixItemFunc: BuildBigQueryReservationLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "reservation_name",
+				Description: "The name of the reservation the assignment belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationAssignmentReservationName),
+			},
+			{
+				Name:        "assignee",
+				Description: "The resource which will use the reservation. E.g. projects/myproject, folders/123, or organizations/456.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "assignee_type",
+				Description: "The type of the assignee (PROJECT, FOLDER or ORGANIZATION).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Assignee").Transform(bigQueryReservationAssigneeType),
+			},
+			{
+				Name:        "job_type",
+				Description: "Which type of jobs will use the reservation (PIPELINE, QUERY, ML_EXTERNAL, BACKGROUND or CONTINUOUS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "State of the assignment (PENDING or ACTIVE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_gemini_in_bigquery",
+				Description: "Whether Gemini in BigQuery is enabled for the assignee.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigQueryReservationProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBigQueryReservationAssignments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The wildcard "-" lists the assignments of all reservations in the location
+	reservationName := "-"
+	if d.EqualsQualString("reservation_name") != "" {
+		reservationName = d.EqualsQualString("reservation_name")
+	}
+
+	// Create Service Connection
+	service, err := BigQueryReservationService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_reservation_assignment.listBigQueryReservationAssignments", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location + "/reservations/" + reservationName
+	resp := service.Projects.Locations.Reservations.Assignments.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *bigqueryreservation.ListAssignmentsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Assignments {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_bigquery_reservation_assignment.listBigQueryReservationAssignments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Assignment names have the form projects/{project}/locations/{location}/reservations/{reservation}/assignments/{assignment}
+func bigQueryReservationAssignmentReservationName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 6 {
+		return nil, nil
+	}
+	return parts[5], nil
+}
+
+func bigQueryReservationAssigneeType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	assignee := types.SafeString(d.Value)
+
+	switch {
+	case strings.HasPrefix(assignee, "projects/"):
+		return "PROJECT", nil
+	case strings.HasPrefix(assignee, "folders/"):
+		return "FOLDER", nil
+	case strings.HasPrefix(assignee, "organizations/"):
+		return "ORGANIZATION", nil
+	}
+	return nil, nil
+}
@@ -129,6 +129,18 @@ func tableGcpBigqueryTable(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Hydrate:     getBigqueryTable,
 			},
+			{
+				Name:        "partition_type",
+				Description: "The time-based partitioning type of the table (DAY, HOUR, MONTH or YEAR).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimePartitioning.Type"),
+			},
+			{
+				Name:        "partition_field",
+				Description: "The field used for time-based partitioning. If not set, the table is partitioned by ingestion time.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimePartitioning.Field"),
+			},
 			{
 				Name:        "require_partition_filter",
 				Description: "If set to true, queries over this table require a partition filter that can be used for partition elimination to be specified.",
@@ -0,0 +1,226 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/bigtableadmin/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBigtableAppProfile(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_bigtable_app_profile",
+		Description: "GCP Bigtable App Profile",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"instance_name", "name"}),
+			Hydrate:    getBigtableAppProfile,
+			Tags:       map[string]string{"service": "bigtable", "action": "appProfiles.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBigtableAppProfiles,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "instance_name",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "bigtable", "action": "appProfiles.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the app profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance the app profile belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceInstanceName),
+			},
+			{
+				Name:        "description",
+				Description: "Long form description of the use case for this app profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "Strongly validated etag for optimistic concurrency control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "routing_policy",
+				Description: "The routing policy of the app profile (SINGLE_CLUSTER or MULTI_CLUSTER).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(bigtableAppProfileRoutingPolicy),
+			},
+			{
+				Name:        "cluster_id",
+				Description: "The cluster to which read/write requests are routed, when single-cluster routing is used.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleClusterRouting.ClusterId"),
+			},
+			{
+				Name:        "allow_transactional_writes",
+				Description: "Whether or not CheckAndMutateRow and ReadModifyWriteRow requests are allowed by this app profile.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SingleClusterRouting.AllowTransactionalWrites"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of requests sent using this app profile (PRIORITY_LOW, PRIORITY_MEDIUM or PRIORITY_HIGH).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StandardIsolation.Priority"),
+			},
+			{
+				Name:        "data_boost_isolation_read_only",
+				Description: "Specifies that this app profile is intended for read-only usage via the Data Boost feature.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "multi_cluster_routing_use_any",
+				Description: "Read/write requests are routed to the nearest cluster in the instance, and will fail over to the nearest cluster that is available in the event of transient errors or delays.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "single_cluster_routing",
+				Description: "Unconditionally routes all read/write requests to a specific cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "standard_isolation",
+				Description: "The standard options used for isolating this app profile's traffic from other use cases.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBigtableAppProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := BigtableAdminService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_app_profile.listBigtableAppProfiles", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The wildcard "-" lists the app profiles of all instances of the project
+	instanceName := "-"
+	if d.EqualsQualString("instance_name") != "" {
+		instanceName = d.EqualsQualString("instance_name")
+	}
+
+	resp := service.Projects.Instances.AppProfiles.List("projects/" + project + "/instances/" + instanceName)
+	if err := resp.Pages(ctx, func(page *bigtableadmin.ListAppProfilesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, appProfile := range page.AppProfiles {
+			d.StreamListItem(ctx, appProfile)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_app_profile.listBigtableAppProfiles", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBigtableAppProfile(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceName := d.EqualsQualString("instance_name")
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if instanceName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BigtableAdminService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_app_profile.getBigtableAppProfile", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Instances.AppProfiles.Get("projects/" + project + "/instances/" + instanceName + "/appProfiles/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_app_profile.getBigtableAppProfile", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func bigtableAppProfileRoutingPolicy(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	appProfile := d.HydrateItem.(*bigtableadmin.AppProfile)
+
+	if appProfile.SingleClusterRouting != nil {
+		return "SINGLE_CLUSTER", nil
+	}
+	if appProfile.MultiClusterRoutingUseAny != nil {
+		return "MULTI_CLUSTER", nil
+	}
+	return nil, nil
+}
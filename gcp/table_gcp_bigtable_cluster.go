@@ -0,0 +1,248 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/bigtableadmin/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBigtableCluster(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_bigtable_cluster",
+		Description: "GCP Bigtable Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"instance_name", "name"}),
+			Hydrate:    getBigtableCluster,
+			Tags:       map[string]string{"service": "bigtable", "action": "clusters.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBigtableClusters,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "instance_name",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "bigtable", "action": "clusters.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance the cluster belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceInstanceName),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the cluster (READY, CREATING, RESIZING or DISABLED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "serve_nodes",
+				Description: "The number of nodes in the cluster. If no value is set, Cloud Bigtable automatically allocates nodes based on your data footprint and optimized for 50% storage utilization.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "default_storage_type",
+				Description: "The type of storage used by this cluster to serve its parent instance's tables (SSD or HDD).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "node_scaling_factor",
+				Description: "The node scaling factor of this cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autoscaling_enabled",
+				Description: "True if autoscaling is configured for this cluster.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ClusterConfig.ClusterAutoscalingConfig").Transform(transform.ToBool),
+			},
+			{
+				Name:        "autoscaling_min_serve_nodes",
+				Description: "Minimum number of nodes to scale down to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ClusterConfig.ClusterAutoscalingConfig.AutoscalingLimits.MinServeNodes"),
+			},
+			{
+				Name:        "autoscaling_max_serve_nodes",
+				Description: "Maximum number of nodes to scale up to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ClusterConfig.ClusterAutoscalingConfig.AutoscalingLimits.MaxServeNodes"),
+			},
+			{
+				Name:        "autoscaling_cpu_utilization_percent",
+				Description: "The cpu utilization that the autoscaler should be trying to achieve.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ClusterConfig.ClusterAutoscalingConfig.AutoscalingTargets.CpuUtilizationPercent"),
+			},
+			{
+				Name:        "autoscaling_storage_utilization_gib_per_node",
+				Description: "The storage utilization that the autoscaler should be trying to achieve.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ClusterConfig.ClusterAutoscalingConfig.AutoscalingTargets.StorageUtilizationGibPerNode"),
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS key used to protect the cluster's data at rest.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionConfig.KmsKeyName"),
+			},
+			{
+				Name:        "cluster_config",
+				Description: "Configuration for this cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(lastPathElement),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBigtableClusters(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := BigtableAdminService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_cluster.listBigtableClusters", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The wildcard "-" lists the clusters of all instances of the project
+	instanceName := "-"
+	if d.EqualsQualString("instance_name") != "" {
+		instanceName = d.EqualsQualString("instance_name")
+	}
+
+	resp := service.Projects.Instances.Clusters.List("projects/" + project + "/instances/" + instanceName)
+	if err := resp.Pages(ctx, func(page *bigtableadmin.ListClustersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, cluster := range page.Clusters {
+			d.StreamListItem(ctx, cluster)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_cluster.listBigtableClusters", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBigtableCluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceName := d.EqualsQualString("instance_name")
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if instanceName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BigtableAdminService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_cluster.getBigtableCluster", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Instances.Clusters.Get("projects/" + project + "/instances/" + instanceName + "/clusters/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_cluster.getBigtableCluster", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Bigtable resource names have the form projects/{project}/instances/{instance}/...
+func bigtableResourceProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 2 {
+		return nil, nil
+	}
+	return parts[1], nil
+}
+
+func bigtableResourceInstanceName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 4 {
+		return nil, nil
+	}
+	return parts[3], nil
+}
+
+func bigtableResourceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://bigtableadmin.googleapis.com/" + name}, nil
+}
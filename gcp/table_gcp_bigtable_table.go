@@ -0,0 +1,210 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/bigtableadmin/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBigtableTable(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_bigtable_table",
+		Description: "GCP Bigtable Table",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"instance_name", "name"}),
+			Hydrate:    getBigtableTable,
+			Tags:       map[string]string{"service": "bigtable", "action": "tables.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listBigtableInstances,
+			Hydrate:       listBigtableTables,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "instance_name",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "bigtable", "action": "tables.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getBigtableTable,
+				Tags: map[string]string{"service": "bigtable", "action": "tables.get"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance the table belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceInstanceName),
+			},
+			{
+				Name:        "granularity",
+				Description: "The granularity at which timestamps are stored in the table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deletion_protection",
+				Description: "If true, the table is protected against data loss and cannot be deleted.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getBigtableTable,
+			},
+			{
+				Name:        "change_stream_retention_period",
+				Description: "How long the data change stream of the table is retained. Change streams are disabled if not set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBigtableTable,
+				Transform:   transform.FromField("ChangeStreamConfig.RetentionPeriod"),
+			},
+			{
+				Name:        "column_families",
+				Description: "The column families configured for this table, mapped by column family ID, along with their garbage collection rules.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "automated_backup_policy",
+				Description: "The automated backup policy of the table. Automated backups are disabled if not set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBigtableTable,
+			},
+			{
+				Name:        "cluster_states",
+				Description: "Map from cluster ID to per-cluster table state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBigtableTable,
+			},
+			{
+				Name:        "restore_info",
+				Description: "If this table was restored from another data source (e.g. a backup), this field will be populated with information about the restore.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBigtableTable,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(bigtableResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBigtableTables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*bigtableadmin.Instance)
+
+	// Minimize API call as per given instance
+	instanceName := d.EqualsQualString("instance_name")
+	if instanceName != "" && instanceName != getLastPathElement(instance.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BigtableAdminService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_table.listBigtableTables", "service_error", err)
+		return nil, err
+	}
+
+	// The list call only supports the partial views, SCHEMA_VIEW returns the
+	// column families and the granularity of the tables
+	resp := service.Projects.Instances.Tables.List(instance.Name).View("SCHEMA_VIEW")
+	if err := resp.Pages(ctx, func(page *bigtableadmin.ListTablesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, table := range page.Tables {
+			d.StreamListItem(ctx, table)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_table.listBigtableTables", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBigtableTable(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := BigtableAdminService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_table.getBigtableTable", "service_error", err)
+		return nil, err
+	}
+
+	var tableName string
+	if h.Item != nil {
+		tableName = h.Item.(*bigtableadmin.Table).Name
+	} else {
+		instanceName := d.EqualsQualString("instance_name")
+		name := d.EqualsQualString("name")
+
+		// Empty Check
+		if instanceName == "" || name == "" {
+			return nil, nil
+		}
+
+		// Get project details
+		projectId, err := getProject(ctx, d, h)
+		if err != nil {
+			return nil, err
+		}
+		project := projectId.(string)
+
+		tableName = "projects/" + project + "/instances/" + instanceName + "/tables/" + name
+	}
+
+	resp, err := service.Projects.Instances.Tables.Get(tableName).View("FULL").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_bigtable_table.getBigtableTable", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
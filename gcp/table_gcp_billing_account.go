@@ -19,7 +19,7 @@ func tableGcpBillingAccount(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.OptionalColumns([]string{"name"}),
 			Hydrate:    getBillingAccount,
-			Tags:    map[string]string{"service": "billing", "action": "accounts.get"},
+			Tags:       map[string]string{"service": "billing", "action": "accounts.get"},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -38,6 +38,16 @@ func tableGcpBillingAccount(_ context.Context) *plugin.Table {
 				Description: "The resource name of the parent billing account, if any.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "currency_code",
+				Description: "The currency in which the billing account is billed and charged, as an ISO 4217 code, for example USD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent",
+				Description: "The parent of the billing account, in the form organizations/{organization_id} for an account owned by an organization, or billingAccounts/{billing_account_id} for a subaccount of a reseller.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "open",
 				Description: "Whether the billing account is open, and will therefore be charged for any usage on associated projects.",
@@ -48,6 +48,54 @@ func tableGcpBillingBudget(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("Budget.DisplayName"),
 			},
 
+			{
+				Name:        "etag",
+				Description: "An opaque identifier for the current version of the budget.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Budget.Etag"),
+			},
+			{
+				Name:        "ownership_scope",
+				Description: "Who can view and manage the budget (ALL_USERS or BILLING_ACCOUNT).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Budget.OwnershipScope"),
+			},
+			{
+				Name:        "calendar_period",
+				Description: "The calendar period the budget is tracked over (MONTH, QUARTER or YEAR). Not set when the budget uses a custom period.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Budget.BudgetFilter.CalendarPeriod").NullIfZero(),
+			},
+			{
+				Name:        "credit_types_treatment",
+				Description: "How the credits are applied to the spend tracked by the budget (INCLUDE_ALL_CREDITS, EXCLUDE_ALL_CREDITS or INCLUDE_SPECIFIED_CREDITS).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Budget.BudgetFilter.CreditTypesTreatment"),
+			},
+			{
+				Name:        "pubsub_topic",
+				Description: "The Pub/Sub topic the budget notifications are published to, for programmatic notifications.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Budget.NotificationsRule.PubsubTopic").NullIfZero(),
+			},
+			{
+				Name:        "disable_default_iam_recipients",
+				Description: "Specifies whether the billing account administrators and users do not receive the budget alert emails.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Budget.NotificationsRule.DisableDefaultIamRecipients"),
+			},
+			{
+				Name:        "enable_project_level_recipients",
+				Description: "Specifies whether the project owners receive the budget alert emails, for a budget scoped to a single project.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Budget.NotificationsRule.EnableProjectLevelRecipients"),
+			},
+			{
+				Name:        "monitoring_notification_channels",
+				Description: "The Cloud Monitoring notification channels the budget alerts are sent to, in the form projects/{project_id}/notificationChannels/{channel_id}.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Budget.NotificationsRule.MonitoringNotificationChannels"),
+			},
 			{
 				Name:        "budget_filter",
 				Description: "Filters that define which resources are used to compute the actual spend against the budget amount.",
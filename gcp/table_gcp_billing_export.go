@@ -0,0 +1,456 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/bigquery/v2"
+)
+
+// Number of days of usage queried when no usage_date or invoice_month qualifier is provided
+const billingExportDefaultLookbackDays = 30
+
+// The standard usage cost export creates one table per billing account, named
+// gcp_billing_export_v1_{billing_account_id}. The wildcard covers all of them.
+const billingExportTablePrefix = "gcp_billing_export_v1_"
+
+// The costs are aggregated per day, project, service, SKU, location and labels.
+// The columns must be kept in the order of the billingExportCost fields, and
+// are grouped by position since labels and location are also export columns.
+const billingExportQuery = `SELECT
+  FORMAT_DATE('%%F', DATE(usage_start_time)) AS usage_date,
+  invoice.month AS invoice_month,
+  billing_account_id,
+  project.id AS project_id,
+  project.name AS project_name,
+  service.id AS service_id,
+  service.description AS service_description,
+  sku.id AS sku_id,
+  sku.description AS sku_description,
+  location.location AS location,
+  location.region AS region,
+  cost_type,
+  currency,
+  TO_JSON_STRING(labels) AS labels,
+  SUM(cost) AS cost,
+  SUM(IFNULL((SELECT SUM(c.amount) FROM UNNEST(credits) AS c), 0)) AS credits,
+  SUM(usage.amount) AS usage_amount,
+  usage.unit AS usage_unit,
+  SUM(usage.amount_in_pricing_units) AS usage_amount_in_pricing_units,
+  usage.pricing_unit AS usage_pricing_unit
+FROM
+  %s
+WHERE
+  %s
+GROUP BY
+  1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 18, 20`
+
+type billingExportCost struct {
+	UsageDate                 *time.Time
+	InvoiceMonth              string
+	BillingAccountId          string
+	ProjectId                 string
+	ProjectName               string
+	ServiceId                 string
+	ServiceDescription        string
+	SkuId                     string
+	SkuDescription            string
+	Location                  string
+	Region                    string
+	CostType                  string
+	Currency                  string
+	Labels                    map[string]string
+	Cost                      float64
+	Credits                   float64
+	UsageAmount               float64
+	UsageUnit                 string
+	UsageAmountInPricingUnits float64
+	UsagePricingUnit          string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpBillingExport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_billing_export",
+		Description: "GCP Billing Export, the daily costs of the Cloud Billing standard usage cost export to BigQuery",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingExportCosts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "usage_date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "invoice_month", Require: plugin.Optional},
+				{Name: "billing_account_id", Require: plugin.Optional},
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "service_id", Require: plugin.Optional},
+				{Name: "service_description", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "bigquery", "action": "jobs.create"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "usage_date",
+				Description: "The day the usage occurred, in UTC.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "invoice_month",
+				Description: "The year and month of the invoice the costs are included in, in the form YYYYMM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_account_id",
+				Description: "The ID of the billing account the usage is charged to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project that generated the usage. Empty for the costs which are not associated with a project, such as support fees.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_name",
+				Description: "The name of the project that generated the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_id",
+				Description: "The ID of the Google Cloud service that reported the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_description",
+				Description: "The name of the Google Cloud service that reported the usage, for example Compute Engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku_id",
+				Description: "The ID of the SKU (stock keeping unit) of the resource used.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku_description",
+				Description: "The description of the SKU of the resource used, for example N1 Predefined Instance Core running in Americas.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location of the usage, at the level of a multi-region, a country, a region or a zone, or global.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The region of the usage, when applicable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost_type",
+				Description: "The type of the cost (regular, tax, adjustment or rounding error).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the costs, as an ISO 4217 code.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost",
+				Description: "The cost of the usage before credits.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "credits",
+				Description: "The sum of the credits applied to the usage, such as committed use discounts, sustained use discounts and free tier. Credits are negative amounts.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "net_cost",
+				Description: "The cost of the usage after credits.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(billingExportNetCost),
+			},
+			{
+				Name:        "usage_amount",
+				Description: "The quantity of usage, in usage_unit.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "usage_unit",
+				Description: "The base unit of the usage, for example byte-seconds.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_amount_in_pricing_units",
+				Description: "The quantity of usage, in usage_pricing_unit.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "usage_pricing_unit",
+				Description: "The unit the SKU is priced in, for example gibibyte month.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the resources that generated the usage.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SkuDescription"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBillingExportCosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	config := GetConfig(d.Connection)
+	if config.BillingExportDataset == nil || *config.BillingExportDataset == "" {
+		return nil, fmt.Errorf("billing_export_dataset must be set in the connection config to query the gcp_billing_export table")
+	}
+
+	// The dataset is either project_id.dataset_id, or dataset_id in the project of the connection
+	dataset := *config.BillingExportDataset
+	if !strings.Contains(dataset, ".") {
+		dataset = project + "." + dataset
+	}
+	table := "`" + dataset + "." + billingExportTablePrefix + "*`"
+
+	conditions, parameters := buildBillingExportConditions(d)
+	query := fmt.Sprintf(billingExportQuery, table, strings.Join(conditions, " AND "))
+
+	// Create Service Connection
+	service, err := BigQueryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_export.listBillingExportCosts", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := int64(1000)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+
+	useLegacySql := false
+	resp, err := service.Jobs.Query(project, &bigquery.QueryRequest{
+		Query:           query,
+		UseLegacySql:    &useLegacySql,
+		ParameterMode:   "NAMED",
+		QueryParameters: parameters,
+		MaxResults:      pageSize,
+	}).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_export.listBillingExportCosts", "api_error", err)
+		return nil, err
+	}
+
+	job := resp.JobReference
+	call := service.Jobs.GetQueryResults(job.ProjectId, job.JobId).Location(job.Location).MaxResults(pageSize)
+
+	// The first page of results is returned with the query when it completes quickly enough
+	if resp.JobComplete {
+		for _, row := range resp.Rows {
+			d.StreamListItem(ctx, billingExportCostFromRow(row))
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if resp.PageToken == "" {
+			return nil, nil
+		}
+		call.PageToken(resp.PageToken)
+	}
+
+	// Wait for the query to complete, the results are not paginated until then
+	for !resp.JobComplete {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		results, err := service.Jobs.GetQueryResults(job.ProjectId, job.JobId).Location(job.Location).MaxResults(0).Do()
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_billing_export.listBillingExportCosts", "api_error", err)
+			return nil, err
+		}
+		resp.JobComplete = results.JobComplete
+	}
+
+	if err := call.Pages(ctx, func(page *bigquery.GetQueryResultsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, row := range page.Rows {
+			d.StreamListItem(ctx, billingExportCostFromRow(row))
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.PageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_export.listBillingExportCosts", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func billingExportNetCost(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	cost := d.HydrateItem.(*billingExportCost)
+	return cost.Cost + cost.Credits, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// buildBillingExportConditions returns the conditions of the WHERE clause and
+// their query parameters. The usage is limited to the last days by default,
+// since the export tables are scanned and billed by BigQuery.
+func buildBillingExportConditions(d *plugin.QueryData) ([]string, []*bigquery.QueryParameter) {
+	var conditions []string
+	var parameters []*bigquery.QueryParameter
+
+	addParameter := func(name string, parameterType string, value string) {
+		parameters = append(parameters, &bigquery.QueryParameter{
+			Name:           name,
+			ParameterType:  &bigquery.QueryParameterType{Type: parameterType},
+			ParameterValue: &bigquery.QueryParameterValue{Value: value},
+		})
+	}
+
+	if quals := d.Quals["usage_date"]; quals != nil {
+		for i, q := range quals.Quals {
+			if q.Value == nil || q.Value.GetTimestampValue() == nil {
+				continue
+			}
+			name := fmt.Sprintf("usage_date_%d", i)
+			usageDate := q.Value.GetTimestampValue().AsTime().UTC()
+
+			// The bounds are truncated to a date, so a strict upper bound later than
+			// midnight must include its own day; Postgres rechecks the exact bound
+			operator := q.Operator
+			if operator == "<" && !usageDate.Equal(usageDate.Truncate(24*time.Hour)) {
+				operator = "<="
+			}
+
+			conditions = append(conditions, fmt.Sprintf("DATE(usage_start_time) %s @%s", operator, name))
+			addParameter(name, "DATE", usageDate.Format("2006-01-02"))
+		}
+	}
+
+	if invoiceMonth := d.EqualsQualString("invoice_month"); invoiceMonth != "" {
+		conditions = append(conditions, "invoice.month = @invoice_month")
+		addParameter("invoice_month", "STRING", invoiceMonth)
+	}
+
+	if len(conditions) == 0 {
+		startDate := time.Now().UTC().AddDate(0, 0, -billingExportDefaultLookbackDays)
+		conditions = append(conditions, "DATE(usage_start_time) >= @usage_date_start")
+		addParameter("usage_date_start", "DATE", startDate.Format("2006-01-02"))
+	}
+
+	equalsQuals := []filterQualMap{
+		{"billing_account_id", "billing_account_id", "string"},
+		{"project_id", "project.id", "string"},
+		{"service_id", "service.id", "string"},
+		{"service_description", "service.description", "string"},
+	}
+	for _, qual := range equalsQuals {
+		if value := d.EqualsQualString(qual.ColumnName); value != "" {
+			conditions = append(conditions, qual.PropertyPath+" = @"+qual.ColumnName)
+			addParameter(qual.ColumnName, "STRING", value)
+		}
+	}
+
+	return conditions, parameters
+}
+
+// billingExportCostFromRow converts a result row, whose cells are in the order
+// of the columns of billingExportQuery
+func billingExportCostFromRow(row *bigquery.TableRow) *billingExportCost {
+	cell := func(i int) string {
+		if i >= len(row.F) || row.F[i] == nil || row.F[i].V == nil {
+			return ""
+		}
+		value, _ := row.F[i].V.(string)
+		return value
+	}
+	number := func(i int) float64 {
+		value, _ := strconv.ParseFloat(cell(i), 64)
+		return value
+	}
+
+	cost := &billingExportCost{
+		InvoiceMonth:              cell(1),
+		BillingAccountId:          cell(2),
+		ProjectId:                 cell(3),
+		ProjectName:               cell(4),
+		ServiceId:                 cell(5),
+		ServiceDescription:        cell(6),
+		SkuId:                     cell(7),
+		SkuDescription:            cell(8),
+		Location:                  cell(9),
+		Region:                    cell(10),
+		CostType:                  cell(11),
+		Currency:                  cell(12),
+		Cost:                      number(14),
+		Credits:                   number(15),
+		UsageAmount:               number(16),
+		UsageUnit:                 cell(17),
+		UsageAmountInPricingUnits: number(18),
+		UsagePricingUnit:          cell(19),
+	}
+
+	if usageDate, err := time.Parse("2006-01-02", cell(0)); err == nil {
+		cost.UsageDate = &usageDate
+	}
+
+	// The labels are exported as an array of key/value pairs
+	var labels []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(cell(13)), &labels); err == nil && len(labels) > 0 {
+		cost.Labels = make(map[string]string, len(labels))
+		for _, label := range labels {
+			cost.Labels[label.Key] = label.Value
+		}
+	}
+
+	return cost
+}
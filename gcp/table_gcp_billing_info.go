@@ -0,0 +1,160 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/cloudbilling/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpBillingInfo(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_billing_info",
+		Description: "GCP Billing Info",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    getBillingInfo,
+			Tags:       map[string]string{"service": "billing", "action": "projects.getBillingInfo"},
+		},
+		List: &plugin.ListConfig{
+			KeyColumns:    plugin.OptionalColumns([]string{"billing_account"}),
+			ParentHydrate: getBillingAccount,
+			Hydrate:       listBillingInfos,
+			Tags:          map[string]string{"service": "billing", "action": "projects.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "The ID of the project the billing information belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_account",
+				Description: "The ID of the billing account the project is linked to. Empty if billing is disabled for the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BillingAccountName").Transform(lastPathElement),
+			},
+			{
+				Name:        "billing_enabled",
+				Description: "Whether billing is enabled for the project. If false, the paid services of the project are disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "name",
+				Description: "The resource name of the billing information, in the form projects/{project_id}/billingInfo.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectId"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudbilling.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: "[Deprecated] The GCP Project in which the resource is located.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// Lists the projects linked to each billing account the caller has access to
+func listBillingInfos(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	acc := h.Item.(*cloudbilling.BillingAccount)
+
+	// Validate - User input(if any) should match with the hydrated billing account
+	if d.EqualsQualString("billing_account") != "" && "billingAccounts/"+d.EqualsQualString("billing_account") != acc.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BillingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_info.listBillingInfos", "service_err", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.BillingAccounts.Projects.List(acc.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudbilling.ListProjectBillingInfoResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, info := range page.ProjectBillingInfo {
+			d.StreamListItem(ctx, info)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_info.listBillingInfos", "api_err", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBillingInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQualString("project_id")
+
+	// Empty Check
+	if projectId == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BillingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_info.getBillingInfo", "service_err", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.GetBillingInfo("projects/" + projectId).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_billing_info.getBillingInfo", "api_err", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
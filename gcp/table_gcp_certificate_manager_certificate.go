@@ -0,0 +1,265 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_certificate",
+		Description: "GCP Certificate Manager Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getCertificateManagerCertificate,
+			Tags:       map[string]string{"service": "certificatemanager", "action": "certificates.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCertificateManagerCertificates,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "certificatemanager", "action": "certificates.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Whether the certificate is managed by Google (MANAGED) or uploaded by the user (SELF_MANAGED).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(certificateManagerCertificateType),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the certificate (DEFAULT, EDGE_CACHE, ALL_REGIONS or CLIENT_AUTH).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The provisioning state of a Google-managed certificate (PROVISIONING, FAILED or ACTIVE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.State"),
+			},
+			{
+				Name:        "issuance_config",
+				Description: "The resource name of the certificate issuance config used to issue a Google-managed private certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.IssuanceConfig"),
+			},
+			{
+				Name:        "expire_time",
+				Description: "The expiry timestamp of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireTime").NullIfZero(),
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "pem_certificate",
+				Description: "The PEM-encoded certificate chain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "san_dnsnames",
+				Description: "The list of Subject Alternative Names of dnsName type defined in the certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domains",
+				Description: "The domains for which a Google-managed certificate should be provisioned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.Domains"),
+			},
+			{
+				Name:        "dns_authorizations",
+				Description: "The authorizations that will be used for performing domain authorization of a Google-managed certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.DnsAuthorizations"),
+			},
+			{
+				Name:        "provisioning_issue",
+				Description: "Information about issues with provisioning a Google-managed certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.ProvisioningIssue"),
+			},
+			{
+				Name:        "authorization_attempt_info",
+				Description: "Detailed state of the latest authorization attempt for each domain specified for a Google-managed certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.AuthorizationAttemptInfo"),
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "certificatemanager.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.listCertificateManagerCertificates", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all locations at once
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Certificates.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListCertificatesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, certificate := range page.Certificates {
+			d.StreamListItem(ctx, certificate)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.listCertificateManagerCertificates", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.getCertificateManagerCertificate", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Certificates.Get("projects/" + project + "/locations/" + location + "/certificates/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.getCertificateManagerCertificate", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func certificateManagerCertificateType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	certificate := d.HydrateItem.(*certificatemanager.Certificate)
+
+	switch {
+	case certificate.Managed != nil:
+		return "MANAGED", nil
+	case certificate.SelfManaged != nil:
+		return "SELF_MANAGED", nil
+	}
+	return nil, nil
+}
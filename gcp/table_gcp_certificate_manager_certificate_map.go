@@ -0,0 +1,193 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerCertificateMap(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_certificate_map",
+		Description: "GCP Certificate Manager Certificate Map",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getCertificateManagerCertificateMap,
+			Tags:       map[string]string{"service": "certificatemanager", "action": "certificateMaps.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCertificateManagerCertificateMaps,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "certificatemanager", "action": "certificateMaps.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the certificate map.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of the certificate map.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the certificate map.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The update timestamp of the certificate map.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "gclb_targets",
+				Description: "A list of the target HTTPS proxies or SSL proxies of the load balancers that reference the certificate map.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the certificate map.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "certificatemanager.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerCertificateMaps(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.listCertificateManagerCertificateMaps", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all locations at once
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.CertificateMaps.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListCertificateMapsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, certificateMap := range page.CertificateMaps {
+			d.StreamListItem(ctx, certificateMap)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.listCertificateManagerCertificateMaps", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerCertificateMap(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.getCertificateManagerCertificateMap", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.CertificateMaps.Get("projects/" + project + "/locations/" + location + "/certificateMaps/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.getCertificateManagerCertificateMap", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
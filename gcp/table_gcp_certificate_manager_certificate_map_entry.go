@@ -0,0 +1,212 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerCertificateMapEntry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_certificate_map_entry",
+		Description: "GCP Certificate Manager Certificate Map Entry",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"certificate_map_name", "name", "location"}),
+			Hydrate:    getCertificateManagerCertificateMapEntry,
+			Tags:       map[string]string{"service": "certificatemanager", "action": "certificateMapEntries.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCertificateManagerCertificateMaps,
+			Hydrate:       listCertificateManagerCertificateMapEntries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "certificate_map_name", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "certificatemanager", "action": "certificateMapEntries.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the certificate map entry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "certificate_map_name",
+				Description: "The name of the certificate map the entry belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of the certificate map entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hostname",
+				Description: "A hostname handled by the entry, for example example.com or *.example.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "matcher",
+				Description: "A predefined matcher for particular cases, other than SNI selection. Set to PRIMARY for the entry used when no other entry matches.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The serving state of the certificate map entry (ACTIVE or PENDING).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the certificate map entry.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The update timestamp of the certificate map entry.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "certificates",
+				Description: "A set of certificates defined for the entry, in the form projects/*/locations/*/certificates/*.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the certificate map entry.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "certificatemanager.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerCertificateMapEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	certificateMap := h.Item.(*certificatemanager.CertificateMap)
+
+	// Minimize the API call with the given certificate map name
+	certificateMapName := d.EqualsQualString("certificate_map_name")
+	if certificateMapName != "" && certificateMapName != getLastPathElement(certificateMap.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.listCertificateManagerCertificateMapEntries", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Locations.CertificateMaps.CertificateMapEntries.List(certificateMap.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListCertificateMapEntriesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, entry := range page.CertificateMapEntries {
+			d.StreamListItem(ctx, entry)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.listCertificateManagerCertificateMapEntries", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerCertificateMapEntry(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	certificateMapName := d.EqualsQualString("certificate_map_name")
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if certificateMapName == "" || name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.getCertificateManagerCertificateMapEntry", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.CertificateMaps.CertificateMapEntries.Get("projects/" + project + "/locations/" + location + "/certificateMaps/" + certificateMapName + "/certificateMapEntries/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.getCertificateManagerCertificateMapEntry", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
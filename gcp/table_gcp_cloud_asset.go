@@ -19,7 +19,13 @@ func tableGcpCloudAsset(ctx context.Context) *plugin.Table {
 		Description: "GCP Cloud Asset",
 		List: &plugin.ListConfig{
 			Hydrate: listCloudAssets,
-			Tags:    map[string]string{"service": "cloudasset", "action": "assets.listResource"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "asset_type", Require: plugin.Optional},
+				{Name: "asset_type_filter", Require: plugin.Optional},
+				{Name: "scope", Require: plugin.Optional},
+				{Name: "content_type", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "cloudasset", "action": "assets.listResource"},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -32,6 +38,24 @@ func tableGcpCloudAsset(ctx context.Context) *plugin.Table {
 				Description: "The type of the asset.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "asset_type_filter",
+				Description: "The asset type pattern the assets were listed with, which supports regular expressions such as compute.googleapis.com.*. Unlike asset_type, it is only used to filter the assets at the API level.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("asset_type_filter"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the asset was listed from, in the form projects/{project_id}, folders/{folder_number} or organizations/{organization_number}. Defaults to the connection's project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("scope"),
+			},
+			{
+				Name:        "content_type",
+				Description: "The content type the asset was listed with, one of RESOURCE, IAM_POLICY, ORG_POLICY, ACCESS_POLICY, OS_INVENTORY or RELATIONSHIP. Defaults to RESOURCE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("content_type"),
+			},
 			{
 				Name:        "update_time",
 				Description: "The last update timestamp of an asset.",
@@ -132,8 +156,35 @@ func listCloudAssets(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	project := projectId.(string)
 
 	input := "projects/" + project
+	if scope := d.EqualsQualString("scope"); scope != "" {
+		input = scope
+	}
+
+	// The resource data is only returned for the RESOURCE content type
+	contentType := "RESOURCE"
+	if d.EqualsQualString("content_type") != "" {
+		contentType = d.EqualsQualString("content_type")
+	}
 
-	resp := service.Assets.List(input).PageSize(*pageSize)
+	resp := service.Assets.List(input).ContentType(contentType).PageSize(*pageSize)
+
+	// Filter the assets by type at the API level. The asset_type values are exact
+	// types, while asset_type_filter can be a pattern such as compute.googleapis.com.*
+	assetTypes := []string{}
+	if d.EqualsQuals["asset_type"] != nil {
+		value := d.EqualsQuals["asset_type"]
+		if value.GetStringValue() != "" {
+			assetTypes = append(assetTypes, value.GetStringValue())
+		} else if value.GetListValue() != nil {
+			assetTypes = append(assetTypes, getListValues(value.GetListValue())...)
+		}
+	}
+	if assetTypeFilter := d.EqualsQualString("asset_type_filter"); assetTypeFilter != "" {
+		assetTypes = append(assetTypes, assetTypeFilter)
+	}
+	if len(assetTypes) > 0 {
+		resp = resp.AssetTypes(assetTypes...)
+	}
 	if err := resp.Pages(ctx, func(page *cloudasset.ListAssetsResponse) error {
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
@@ -0,0 +1,332 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudbuild/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudBuildBuild(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_build_build",
+		Description: "GCP Cloud Build Build",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "location"}),
+			Hydrate:    getCloudBuildBuild,
+			Tags:       map[string]string{"service": "cloudbuild", "action": "builds.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudBuildBuilds,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "build_trigger_id", Require: plugin.Optional},
+				{Name: "create_time", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<="}},
+			},
+			Tags: map[string]string{"service": "cloudbuild", "action": "builds.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier of the build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The resource name of the build, in the form projects/{project}/locations/{location}/builds/{build}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the build (PENDING, QUEUED, WORKING, SUCCESS, FAILURE, INTERNAL_ERROR, TIMEOUT, CANCELLED or EXPIRED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_detail",
+				Description: "Customer-readable message about the current status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_trigger_id",
+				Description: "The ID of the trigger that started the build, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time at which the request to create the build was received.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "start_time",
+				Description: "Time at which execution of the build was started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime").NullIfZero(),
+			},
+			{
+				Name:        "finish_time",
+				Description: "Time at which execution of the build was finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FinishTime").NullIfZero(),
+			},
+			{
+				Name:        "duration_seconds",
+				Description: "The time taken to execute the build, in seconds. Null until the build has finished.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(cloudBuildBuildDuration),
+			},
+			{
+				Name:        "timeout",
+				Description: "Amount of time that the build should be allowed to run, to second granularity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "queue_ttl",
+				Description: "Time to live for the build in the queue. The build expires if it is not started within this duration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_account",
+				Description: "The service account used for all user-controlled operations of the build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_url",
+				Description: "URL to the logs of the build in the Google Cloud Console.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "logs_bucket",
+				Description: "Cloud Storage bucket where the logs of the build are written.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "images",
+				Description: "A list of images to be pushed upon the successful completion of all build steps.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source",
+				Description: "The location of the source files to build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_provenance",
+				Description: "A permanent fixed identifier for the source of the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "steps",
+				Description: "The operations to be performed on the workspace.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "results",
+				Description: "Results of the build, such as the pushed images and artifacts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "substitutions",
+				Description: "Substitutions data for the build resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "options",
+				Description: "Special options for the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "approval",
+				Description: "Describes the state of the approval of the build, if approval is required.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "failure_info",
+				Description: "Contains information about the build when the status is FAILURE.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timing",
+				Description: "Stores timing information for phases of the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "warnings",
+				Description: "Non-fatal problems encountered during the execution of the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "build_tags",
+				Description: "Tags for annotation of the build.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudbuild.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudBuildBuilds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudBuildService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_build.listCloudBuildBuilds", "service_error", err)
+		return nil, err
+	}
+
+	// Builds that are not run in a regional worker pool live in the global location
+	location := d.EqualsQualString("location")
+	if location == "" {
+		location = "global"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	filter := buildCloudBuildBuildFilter(d.Quals)
+
+	resp := service.Projects.Locations.Builds.List("projects/" + project + "/locations/" + location).Filter(filter).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudbuild.ListBuildsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, build := range page.Builds {
+			d.StreamListItem(ctx, build)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_build.listCloudBuildBuilds", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudBuildBuild(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if id == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudBuildService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_build.getCloudBuildBuild", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Builds.Get("projects/" + project + "/locations/" + location + "/builds/" + id).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_build.getCloudBuildBuild", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudBuildBuildDuration(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	build := d.HydrateItem.(*cloudbuild.Build)
+
+	if build.StartTime == "" || build.FinishTime == "" {
+		return nil, nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339Nano, build.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	finishTime, err := time.Parse(time.RFC3339Nano, build.FinishTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return finishTime.Sub(startTime).Seconds(), nil
+}
+
+//// UTILITY FUNCTION
+
+// https://cloud.google.com/build/docs/view-build-results#filtering_build_results_using_queries
+func buildCloudBuildBuildFilter(quals plugin.KeyColumnQualMap) string {
+	filterQuals := []filterQualMap{
+		{"status", "status", "string"},
+		{"build_trigger_id", "trigger_id", "string"},
+		{"create_time", "create_time", "timestamp"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, quals)
+
+	return strings.Join(filters, " AND ")
+}
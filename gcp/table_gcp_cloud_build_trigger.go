@@ -0,0 +1,328 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudbuild/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudBuildTrigger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_build_trigger",
+		Description: "GCP Cloud Build Trigger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "location"}),
+			Hydrate:    getCloudBuildTrigger,
+			Tags:       map[string]string{"service": "cloudbuild", "action": "triggers.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudBuildTriggers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "cloudbuild", "action": "triggers.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "User-assigned name of the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Human-readable description of the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disabled",
+				Description: "If true, the trigger will never automatically execute a build.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time when the trigger was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of the events the trigger is listening to (REPO, WEBHOOK, PUBSUB or MANUAL).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository",
+				Description: "The repository the trigger listens to, for example owner/name for a GitHub repository or the name of a Cloud Source Repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudBuildTriggerRepository).NullIfZero(),
+			},
+			{
+				Name:        "branch_filter",
+				Description: "Regex of the branches that trigger a build, if the trigger listens to pushes or pull requests on branches.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudBuildTriggerBranchFilter).NullIfZero(),
+			},
+			{
+				Name:        "filename",
+				Description: "Path, from the source root, to the build configuration file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "A Common Expression Language string used to filter the events that trigger a build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_account",
+				Description: "The service account used for all user-controlled operations of the builds started by the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "include_build_logs",
+				Description: "Whether the build logs are sent to GitHub (INCLUDE_BUILD_LOGS_WITH_STATUS) or not.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "approval_required",
+				Description: "Whether the builds started by the trigger need to be approved before they execute.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ApprovalConfig.ApprovalRequired"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The resource name of the trigger, in the form projects/{project}/locations/{location}/triggers/{trigger}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "approval_config",
+				Description: "Configuration for manual approval to start a build invocation of the trigger.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "substitutions",
+				Description: "Substitutions for the build resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "included_files",
+				Description: "If any of the files altered in a commit pass the included_files filter, a build is triggered.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ignored_files",
+				Description: "Glob patterns of the files that are ignored when deciding whether a commit triggers a build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "github",
+				Description: "The GitHub events that trigger a build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "trigger_template",
+				Description: "Template describing the types of source changes that trigger a build, for Cloud Source Repositories.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "repository_event_config",
+				Description: "The configuration of the repository events that trigger a build, for repositories connected with Cloud Build repositories.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pubsub_config",
+				Description: "The Pub/Sub configuration of a trigger that listens to Pub/Sub messages.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "webhook_config",
+				Description: "The webhook configuration of a trigger that listens to webhooks.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_to_build",
+				Description: "The repo and ref of the repository from which to build, for triggers that are not started by a repository event.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "git_file_source",
+				Description: "The file source describing the local or remote build file.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "build",
+				Description: "Contents of the build template, if the build configuration is inlined in the trigger.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "trigger_tags",
+				Description: "Tags for annotation of the trigger.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "Id"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceName").TransformP(locationResourceAkas, "cloudbuild.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudBuildTriggers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudBuildService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_trigger.listCloudBuildTriggers", "service_error", err)
+		return nil, err
+	}
+
+	// Triggers that are not bound to a region live in the global location
+	location := d.EqualsQualString("location")
+	if location == "" {
+		location = "global"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Triggers.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudbuild.ListBuildTriggersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, trigger := range page.Triggers {
+			d.StreamListItem(ctx, trigger)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_trigger.listCloudBuildTriggers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudBuildTrigger(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if id == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudBuildService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_trigger.getCloudBuildTrigger", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Triggers.Get("projects/" + project + "/locations/" + location + "/triggers/" + id).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_build_trigger.getCloudBuildTrigger", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudBuildTriggerRepository(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	trigger := d.HydrateItem.(*cloudbuild.BuildTrigger)
+
+	switch {
+	case trigger.Github != nil:
+		return trigger.Github.Owner + "/" + trigger.Github.Name, nil
+	case trigger.TriggerTemplate != nil:
+		return trigger.TriggerTemplate.RepoName, nil
+	case trigger.RepositoryEventConfig != nil:
+		return trigger.RepositoryEventConfig.Repository, nil
+	}
+	return nil, nil
+}
+
+func cloudBuildTriggerBranchFilter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	trigger := d.HydrateItem.(*cloudbuild.BuildTrigger)
+
+	switch {
+	case trigger.Github != nil && trigger.Github.Push != nil:
+		return trigger.Github.Push.Branch, nil
+	case trigger.Github != nil && trigger.Github.PullRequest != nil:
+		return trigger.Github.PullRequest.Branch, nil
+	case trigger.TriggerTemplate != nil:
+		return trigger.TriggerTemplate.BranchName, nil
+	case trigger.RepositoryEventConfig != nil && trigger.RepositoryEventConfig.Push != nil:
+		return trigger.RepositoryEventConfig.Push.Branch, nil
+	case trigger.RepositoryEventConfig != nil && trigger.RepositoryEventConfig.PullRequest != nil:
+		return trigger.RepositoryEventConfig.PullRequest.Branch, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,254 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/clouddeploy/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudDeployDeliveryPipeline(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_deploy_delivery_pipeline",
+		Description: "GCP Cloud Deploy Delivery Pipeline",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getCloudDeployDeliveryPipeline,
+			Tags:       map[string]string{"service": "clouddeploy", "action": "deliveryPipelines.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudDeployDeliveryPipelines,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "clouddeploy", "action": "deliveryPipelines.list"},
+		},
+		GetMatrixItemFunc: BuildCloudDeployLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the delivery pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "uid",
+				Description: "Unique identifier of the delivery pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Description of the delivery pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "suspended",
+				Description: "When suspended, no new releases or rollouts can be created, but in-progress ones will complete.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time at which the pipeline was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Most recent time at which the pipeline was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "A checksum computed by the server based on the value of other fields.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the delivery pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "target_ids",
+				Description: "The IDs of the targets the pipeline deploys to, in the order of the stages.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(cloudDeployDeliveryPipelineTargetIds),
+			},
+			{
+				Name:        "stages",
+				Description: "Each stage specifies configuration for a target. The ordering of this list defines the promotion flow.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SerialPipeline.Stages"),
+			},
+			{
+				Name:        "condition",
+				Description: "Information around the state of the delivery pipeline, such as whether its targets exist.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "User annotations. These attributes can only be set and used by the user, and not by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels are attributes that can be set and used by both the user and by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "clouddeploy.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudDeployDeliveryPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_delivery_pipeline.listCloudDeployDeliveryPipelines", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.DeliveryPipelines.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *clouddeploy.ListDeliveryPipelinesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, pipeline := range page.DeliveryPipelines {
+			d.StreamListItem(ctx, pipeline)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_delivery_pipeline.listCloudDeployDeliveryPipelines", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudDeployDeliveryPipeline(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_delivery_pipeline.getCloudDeployDeliveryPipeline", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.DeliveryPipelines.Get("projects/" + project + "/locations/" + location + "/deliveryPipelines/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_delivery_pipeline.getCloudDeployDeliveryPipeline", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudDeployDeliveryPipelineTargetIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	pipeline := d.HydrateItem.(*clouddeploy.DeliveryPipeline)
+
+	if pipeline.SerialPipeline == nil {
+		return nil, nil
+	}
+
+	targetIds := []string{}
+	for _, stage := range pipeline.SerialPipeline.Stages {
+		targetIds = append(targetIds, stage.TargetId)
+	}
+	return targetIds, nil
+}
@@ -0,0 +1,285 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/clouddeploy/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudDeployRelease(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_deploy_release",
+		Description: "GCP Cloud Deploy Release",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "delivery_pipeline_name", "location"}),
+			Hydrate:    getCloudDeployRelease,
+			Tags:       map[string]string{"service": "clouddeploy", "action": "releases.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudDeployDeliveryPipelines,
+			Hydrate:       listCloudDeployReleases,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "delivery_pipeline_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "clouddeploy", "action": "releases.list"},
+		},
+		GetMatrixItemFunc: BuildCloudDeployLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "delivery_pipeline_name",
+				Description: "The name of the delivery pipeline the release belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "uid",
+				Description: "Unique identifier of the release.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Description of the release.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "abandoned",
+				Description: "Indicates whether this is an abandoned release. Abandoned releases cannot be promoted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "render_state",
+				Description: "Current state of the render operation (SUCCEEDED, FAILED or IN_PROGRESS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time at which the release was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "render_start_time",
+				Description: "Time at which the render began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RenderStartTime").NullIfZero(),
+			},
+			{
+				Name:        "render_end_time",
+				Description: "Time at which the render completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RenderEndTime").NullIfZero(),
+			},
+			{
+				Name:        "skaffold_version",
+				Description: "The Skaffold version to use when operating on this release.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "skaffold_config_uri",
+				Description: "Cloud Storage URI of tar.gz archive containing Skaffold configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "skaffold_config_path",
+				Description: "Filepath of the Skaffold config inside of the config URI.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A checksum computed by the server based on the value of other fields.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "build_artifacts",
+				Description: "List of artifacts to pass through to Skaffold command.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "condition",
+				Description: "Information around the state of the release.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "delivery_pipeline_snapshot",
+				Description: "Snapshot of the parent pipeline taken at release creation time.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_snapshots",
+				Description: "Snapshot of the targets taken at release creation time.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_artifacts",
+				Description: "Map from target ID to the target artifacts created during the render operation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_renders",
+				Description: "Map from target ID to details of the render operation for that target.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deploy_parameters",
+				Description: "The deploy parameters to use for all targets in this release.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "User annotations. These attributes can only be set and used by the user, and not by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels are attributes that can be set and used by both the user and by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "clouddeploy.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudDeployReleases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipeline := h.Item.(*clouddeploy.DeliveryPipeline)
+
+	// Minimize the API call with the given delivery pipeline name
+	pipelineName := d.EqualsQualString("delivery_pipeline_name")
+	if pipelineName != "" && pipelineName != getLastPathElement(pipeline.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_release.listCloudDeployReleases", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Locations.DeliveryPipelines.Releases.List(pipeline.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *clouddeploy.ListReleasesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, release := range page.Releases {
+			d.StreamListItem(ctx, release)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_release.listCloudDeployReleases", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudDeployRelease(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	pipelineName := d.EqualsQualString("delivery_pipeline_name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || pipelineName == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_release.getCloudDeployRelease", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.DeliveryPipelines.Releases.Get("projects/" + project + "/locations/" + location + "/deliveryPipelines/" + pipelineName + "/releases/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_release.getCloudDeployRelease", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,280 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/clouddeploy/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudDeployRollout(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_deploy_rollout",
+		Description: "GCP Cloud Deploy Rollout",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudDeployDeliveryPipelines,
+			Hydrate:       listCloudDeployRollouts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "delivery_pipeline_name", Require: plugin.Optional},
+				{Name: "release_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "clouddeploy", "action": "rollouts.list"},
+		},
+		GetMatrixItemFunc: BuildCloudDeployLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rollout.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "release_name",
+				Description: "The name of the release the rollout belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 7),
+			},
+			{
+				Name:        "delivery_pipeline_name",
+				Description: "The name of the delivery pipeline the rollout belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "uid",
+				Description: "Unique identifier of the rollout.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_id",
+				Description: "The ID of the target the rollout is deployed to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Current state of the rollout, for example SUCCEEDED, FAILED, IN_PROGRESS or PENDING_APPROVAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "approval_state",
+				Description: "Approval state of the rollout (NEEDS_APPROVAL, DOES_NOT_NEED_APPROVAL, APPROVED or REJECTED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Description of the rollout.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time at which the rollout was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "approve_time",
+				Description: "Time at which the rollout was approved.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ApproveTime").NullIfZero(),
+			},
+			{
+				Name:        "enqueue_time",
+				Description: "Time at which the rollout was enqueued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EnqueueTime").NullIfZero(),
+			},
+			{
+				Name:        "deploy_start_time",
+				Description: "Time at which the rollout started deploying.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeployStartTime").NullIfZero(),
+			},
+			{
+				Name:        "deploy_end_time",
+				Description: "Time at which the rollout finished deploying.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeployEndTime").NullIfZero(),
+			},
+			{
+				Name:        "deploy_failure_cause",
+				Description: "The reason the deploy failed, if the rollout failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "failure_reason",
+				Description: "Additional information about the rollout failure, if available.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deploying_build",
+				Description: "The resource name of the Cloud Build build that is used to deploy the rollout.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rollback_of_rollout",
+				Description: "Name of the rollout that is rolled back by this rollout, if it is a rollback.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "controller_rollout",
+				Description: "Name of the controller rollout, if this rollout is a child rollout of a multi target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A checksum computed by the server based on the value of other fields.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the rollout.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "rolled_back_by_rollouts",
+				Description: "Names of the rollouts that rolled back this rollout.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "phases",
+				Description: "The phases that represent the workflows of the rollout.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata",
+				Description: "Metadata contains information about the rollout, such as the Cloud Run revision it deployed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "User annotations. These attributes can only be set and used by the user, and not by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels are attributes that can be set and used by both the user and by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "clouddeploy.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudDeployRollouts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipeline := h.Item.(*clouddeploy.DeliveryPipeline)
+
+	// Minimize the API call with the given delivery pipeline name
+	pipelineName := d.EqualsQualString("delivery_pipeline_name")
+	if pipelineName != "" && pipelineName != getLastPathElement(pipeline.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_rollout.listCloudDeployRollouts", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// List the releases of the pipeline, unless the release name is given
+	releaseNames := []string{}
+	if releaseName := d.EqualsQualString("release_name"); releaseName != "" {
+		releaseNames = append(releaseNames, pipeline.Name+"/releases/"+releaseName)
+	} else {
+		resp := service.Projects.Locations.DeliveryPipelines.Releases.List(pipeline.Name).PageSize(1000)
+		if err := resp.Pages(ctx, func(page *clouddeploy.ListReleasesResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, release := range page.Releases {
+				releaseNames = append(releaseNames, release.Name)
+			}
+			return nil
+		}); err != nil {
+			plugin.Logger(ctx).Error("gcp_cloud_deploy_rollout.listCloudDeployRollouts", "api_error", err)
+			return nil, err
+		}
+	}
+
+	for _, releaseName := range releaseNames {
+		resp := service.Projects.Locations.DeliveryPipelines.Releases.Rollouts.List(releaseName).PageSize(*pageSize)
+		if err := resp.Pages(ctx, func(page *clouddeploy.ListRolloutsResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, rollout := range page.Rollouts {
+				d.StreamListItem(ctx, rollout)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			plugin.Logger(ctx).Error("gcp_cloud_deploy_rollout.listCloudDeployRollouts", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
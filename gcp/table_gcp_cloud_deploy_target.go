@@ -0,0 +1,292 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/clouddeploy/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudDeployTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_deploy_target",
+		Description: "GCP Cloud Deploy Target",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getCloudDeployTarget,
+			Tags:       map[string]string{"service": "clouddeploy", "action": "targets.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudDeployTargets,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "clouddeploy", "action": "targets.list"},
+		},
+		GetMatrixItemFunc: BuildCloudDeployLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "target_id",
+				Description: "Resource id of the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "uid",
+				Description: "Unique identifier of the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Description of the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "require_approval",
+				Description: "Whether or not the target requires approval before a rollout is deployed to it.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "target_type",
+				Description: "The type of the runtime the target deploys to (GKE, ANTHOS_CLUSTER, RUN, MULTI_TARGET or CUSTOM_TARGET).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudDeployTargetType),
+			},
+			{
+				Name:        "create_time",
+				Description: "Time at which the target was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Most recent time at which the target was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "A checksum computed by the server based on the value of other fields.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "execution_configs",
+				Description: "Configurations for all execution that relates to this target, such as the service account and worker pool used to render and deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "gke",
+				Description: "Information specifying a GKE cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "anthos_cluster",
+				Description: "Information specifying an Anthos cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "run",
+				Description: "Information specifying a Cloud Run deployment target.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "multi_target",
+				Description: "Information specifying a multi target.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "custom_target",
+				Description: "Information specifying a custom target.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deploy_parameters",
+				Description: "The deploy parameters to use for this target.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "associated_entities",
+				Description: "Map of entity IDs to their associated entities, such as GKE clusters or service mesh resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "User annotations. These attributes can only be set and used by the user, and not by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels are attributes that can be set and used by both the user and by Cloud Deploy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "clouddeploy.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudDeployTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_target.listCloudDeployTargets", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Targets.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *clouddeploy.ListTargetsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, target := range page.Targets {
+			d.StreamListItem(ctx, target)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_target.listCloudDeployTargets", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudDeployTarget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudDeployService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_target.getCloudDeployTarget", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Targets.Get("projects/" + project + "/locations/" + location + "/targets/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_deploy_target.getCloudDeployTarget", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudDeployTargetType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	target := d.HydrateItem.(*clouddeploy.Target)
+
+	switch {
+	case target.Gke != nil:
+		return "GKE", nil
+	case target.AnthosCluster != nil:
+		return "ANTHOS_CLUSTER", nil
+	case target.Run != nil:
+		return "RUN", nil
+	case target.MultiTarget != nil:
+		return "MULTI_TARGET", nil
+	case target.CustomTarget != nil:
+		return "CUSTOM_TARGET", nil
+	}
+	return nil, nil
+}
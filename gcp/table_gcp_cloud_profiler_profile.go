@@ -0,0 +1,156 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudprofiler/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudProfilerProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_profiler_profile",
+		Description: "GCP Cloud Profiler Profile",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudProfilerProfiles,
+			Tags:    map[string]string{"service": "cloudprofiler", "action": "profiles.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The opaque, server-assigned, unique ID of the profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "profile_type",
+				Description: "The type of the profile, for example CPU, WALL, HEAP, THREADS or CONTENTION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_target",
+				Description: "The target of the deployment the profile corresponds to, usually the name of the profiled service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Deployment.Target"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the profile.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime").NullIfZero(),
+			},
+			{
+				Name:        "duration",
+				Description: "The requested duration of the profiling session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "deployment_labels",
+				Description: "The labels of the deployment, for example its zone, version or language.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Deployment.Labels"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels associated to this specific profile.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudprofiler.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudProfilerProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudProfilerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_profiler_profile.listCloudProfilerProfiles", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Profiles.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudprofiler.ListProfilesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, profile := range page.Profiles {
+			d.StreamListItem(ctx, profile)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_profiler_profile.listCloudProfilerProfiles", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -144,7 +144,62 @@ func tableGcpCloudRunJob(ctx context.Context) *plugin.Table {
 				Transform:   transform.FromGo().NullIfZero(),
 			},
 
+			{
+				Name:        "container_image",
+				Description: "The container image used by the tasks of this job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.Template.Containers").Transform(cloudRunMainContainerImage),
+			},
+			{
+				Name:        "service_account",
+				Description: "Email address of the IAM service account associated with the tasks of this job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.Template.ServiceAccount"),
+			},
+			{
+				Name:        "task_count",
+				Description: "Specifies the desired number of tasks the execution should run.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Template.TaskCount"),
+			},
+			{
+				Name:        "parallelism",
+				Description: "Specifies the maximum desired number of tasks the execution should run at given time.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Template.Parallelism"),
+			},
+			{
+				Name:        "max_retries",
+				Description: "Number of retries allowed per task, before marking this task failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Template.Template.MaxRetries"),
+			},
+			{
+				Name:        "task_timeout",
+				Description: "Max allowed time duration the task may be active before the system will actively try to mark it failed and kill associated containers.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.Template.Timeout"),
+			},
+			{
+				Name:        "vpc_connector",
+				Description: "VPC Access connector name used by the tasks of this job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.Template.VpcAccess.Connector"),
+			},
+			{
+				Name:        "vpc_egress",
+				Description: "Traffic VPC egress settings. Possible values are ALL_TRAFFIC and PRIVATE_RANGES_ONLY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.Template.VpcAccess.Egress"),
+			},
+
 			// JSON fields
+			{
+				Name:        "resource_limits",
+				Description: "Resource limits (cpu and memory) of the job container.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Template.Template.Containers").Transform(cloudRunMainContainerLimits),
+			},
 			{
 				Name:        "annotations",
 				Description: "Unstructured key value map that may be set by external tools to store and arbitrary metadata.",
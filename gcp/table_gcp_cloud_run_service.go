@@ -164,7 +164,62 @@ func tableGcpCloudRunService(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 			},
 
+			{
+				Name:        "container_image",
+				Description: "The container image of the serving container in the revision template. For multi-container services, this is the ingress container.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.Containers").Transform(cloudRunMainContainerImage),
+			},
+			{
+				Name:        "service_account",
+				Description: "Email address of the IAM service account associated with the revision of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.ServiceAccount"),
+			},
+			{
+				Name:        "min_instance_count",
+				Description: "Minimum number of serving instances that this resource should have.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Template.Scaling.MinInstanceCount"),
+			},
+			{
+				Name:        "max_instance_count",
+				Description: "Maximum number of serving instances that this resource should have.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Template.Scaling.MaxInstanceCount"),
+			},
+			{
+				Name:        "max_instance_request_concurrency",
+				Description: "The maximum number of concurrent requests allowed per container instance.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Template.MaxInstanceRequestConcurrency"),
+			},
+			{
+				Name:        "vpc_connector",
+				Description: "VPC Access connector name used by the revision template.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.VpcAccess.Connector"),
+			},
+			{
+				Name:        "vpc_egress",
+				Description: "Traffic VPC egress settings. Possible values are ALL_TRAFFIC and PRIVATE_RANGES_ONLY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Template.VpcAccess.Egress"),
+			},
+			{
+				Name:        "latest_revision_ready",
+				Description: "True if the latest created revision is also the latest ready revision.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(cloudRunServiceLatestRevisionReady),
+			},
+
 			// JSON fields
+			{
+				Name:        "resource_limits",
+				Description: "Resource limits (cpu and memory) of the serving container in the revision template.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Template.Containers").Transform(cloudRunMainContainerLimits),
+			},
 			{
 				Name:        "annotations",
 				Description: "Unstructured key value map that may be set by external tools to store and arbitrary metadata.",
@@ -429,3 +484,42 @@ func cloudRunServiceData(ctx context.Context, h *transform.TransformData) (inter
 
 	return turbotData[param], nil
 }
+
+func cloudRunServiceLatestRevisionReady(_ context.Context, h *transform.TransformData) (interface{}, error) {
+	data := h.HydrateItem.(*run.GoogleCloudRunV2Service)
+	if data.LatestCreatedRevision == "" {
+		return nil, nil
+	}
+	return data.LatestCreatedRevision == data.LatestReadyRevision, nil
+}
+
+func cloudRunMainContainerImage(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	container := cloudRunMainContainer(d.Value)
+	if container == nil {
+		return nil, nil
+	}
+	return container.Image, nil
+}
+
+func cloudRunMainContainerLimits(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	container := cloudRunMainContainer(d.Value)
+	if container == nil || container.Resources == nil {
+		return nil, nil
+	}
+	return container.Resources.Limits, nil
+}
+
+// cloudRunMainContainer returns the container receiving traffic, i.e. the one
+// exposing a port, falling back to the first container.
+func cloudRunMainContainer(value interface{}) *run.GoogleCloudRunV2Container {
+	containers, ok := value.([]*run.GoogleCloudRunV2Container)
+	if !ok || len(containers) == 0 {
+		return nil
+	}
+	for _, c := range containers {
+		if c != nil && len(c.Ports) > 0 {
+			return c
+		}
+	}
+	return containers[0]
+}
@@ -0,0 +1,283 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/cloudscheduler/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudSchedulerJob(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_scheduler_job",
+		Description: "GCP Cloud Scheduler Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getCloudSchedulerJob,
+			Tags:       map[string]string{"service": "cloudscheduler", "action": "jobs.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudSchedulerJobs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "cloudscheduler", "action": "jobs.list"},
+		},
+		GetMatrixItemFunc: BuildCloudSchedulerLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "A human-readable description for the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "State of the job (ENABLED, PAUSED, DISABLED or UPDATE_FAILED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule",
+				Description: "The frequency of the job, in unix-cron format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_zone",
+				Description: "Specifies the time zone to be used in interpreting the schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_type",
+				Description: "The type of the target of the job (HTTP, APP_ENGINE_HTTP or PUBSUB).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudSchedulerJobTargetType),
+			},
+			{
+				Name:        "http_target_uri",
+				Description: "The full URI path that the request will be sent to, for HTTP targets.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HttpTarget.Uri"),
+			},
+			{
+				Name:        "http_target_service_account",
+				Description: "The service account used to generate the OIDC or OAuth token sent to HTTP targets.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HttpTarget.OidcToken.ServiceAccountEmail", "HttpTarget.OauthToken.ServiceAccountEmail"),
+			},
+			{
+				Name:        "pubsub_target_topic_name",
+				Description: "The name of the Cloud Pub/Sub topic to which messages will be published when the job is delivered, for Pub/Sub targets.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PubsubTarget.TopicName"),
+			},
+			{
+				Name:        "schedule_time",
+				Description: "The next time the job is scheduled.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleTime").NullIfZero(),
+			},
+			{
+				Name:        "last_attempt_time",
+				Description: "The time the last job attempt started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastAttemptTime").NullIfZero(),
+			},
+			{
+				Name:        "last_attempt_status_code",
+				Description: "The status code of the last attempted execution of the job. 0 means the last attempt succeeded.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Status.Code"),
+			},
+			{
+				Name:        "last_attempt_status_message",
+				Description: "The error message of the last attempted execution of the job, if it failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Message"),
+			},
+			{
+				Name:        "user_update_time",
+				Description: "The creation time of the job, or the time it was last updated by the user.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UserUpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "attempt_deadline",
+				Description: "The deadline for job attempts. If the request handler does not respond by this deadline then the request is cancelled and the attempt is marked as a DEADLINE_EXCEEDED failure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "retry_config",
+				Description: "Settings that determine the retry behavior.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http_target",
+				Description: "HTTP target of the job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "app_engine_http_target",
+				Description: "App Engine HTTP target of the job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pubsub_target",
+				Description: "Pub/Sub target of the job.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudscheduler.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudSchedulerJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudSchedulerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_scheduler_job.listCloudSchedulerJobs", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Jobs.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudscheduler.ListJobsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, job := range page.Jobs {
+			d.StreamListItem(ctx, job)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_scheduler_job.listCloudSchedulerJobs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudSchedulerJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudSchedulerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_scheduler_job.getCloudSchedulerJob", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Jobs.Get("projects/" + project + "/locations/" + location + "/jobs/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_scheduler_job.getCloudSchedulerJob", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudSchedulerJobTargetType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	job := d.HydrateItem.(*cloudscheduler.Job)
+
+	switch {
+	case job.HttpTarget != nil:
+		return "HTTP", nil
+	case job.AppEngineHttpTarget != nil:
+		return "APP_ENGINE_HTTP", nil
+	case job.PubsubTarget != nil:
+		return "PUBSUB", nil
+	}
+	return nil, nil
+}
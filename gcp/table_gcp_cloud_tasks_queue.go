@@ -0,0 +1,276 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/cloudtasks/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudTasksQueue(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_tasks_queue",
+		Description: "GCP Cloud Tasks Queue",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getCloudTasksQueue,
+			Tags:       map[string]string{"service": "cloudtasks", "action": "queues.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudTasksQueues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "cloudtasks", "action": "queues.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudTasksQueueIamPolicy,
+				Tags: map[string]string{"service": "cloudtasks", "action": "queues.getIamPolicy"},
+			},
+		},
+		GetMatrixItemFunc: BuildCloudTasksLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the queue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the queue (RUNNING, PAUSED or DISABLED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "purge_time",
+				Description: "The last time this queue was purged.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PurgeTime").NullIfZero(),
+			},
+			{
+				Name:        "max_dispatches_per_second",
+				Description: "The maximum rate at which tasks are dispatched from this queue.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("RateLimits.MaxDispatchesPerSecond"),
+			},
+			{
+				Name:        "max_burst_size",
+				Description: "The max burst size, which limits how fast tasks in the queue are processed when many tasks are in the queue and the rate is high.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RateLimits.MaxBurstSize"),
+			},
+			{
+				Name:        "max_concurrent_dispatches",
+				Description: "The maximum number of concurrent tasks that Cloud Tasks allows to be dispatched for this queue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RateLimits.MaxConcurrentDispatches"),
+			},
+			{
+				Name:        "max_attempts",
+				Description: "The number of attempts per task. -1 indicates unlimited attempts.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetryConfig.MaxAttempts"),
+			},
+			{
+				Name:        "max_retry_duration",
+				Description: "The time limit for retrying a failed task, measured from when the task was first attempted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RetryConfig.MaxRetryDuration"),
+			},
+			{
+				Name:        "min_backoff",
+				Description: "The minimum amount of time to wait before retrying a task after it fails.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RetryConfig.MinBackoff"),
+			},
+			{
+				Name:        "max_backoff",
+				Description: "The maximum amount of time to wait before retrying a task after it fails.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RetryConfig.MaxBackoff"),
+			},
+			{
+				Name:        "max_doublings",
+				Description: "The time between retries will double max_doublings times.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetryConfig.MaxDoublings"),
+			},
+			{
+				Name:        "logging_sampling_ratio",
+				Description: "Specifies the fraction of operations to write to Cloud Logging.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("StackdriverLoggingConfig.SamplingRatio"),
+			},
+			{
+				Name:        "app_engine_routing_override",
+				Description: "Overrides for task-level App Engine routing.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http_target",
+				Description: "Modifies HTTP target for HTTP tasks.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudTasksQueueIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudtasks.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudTasksQueues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudTasksService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_tasks_queue.listCloudTasksQueues", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Queues.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudtasks.ListQueuesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, queue := range page.Queues {
+			d.StreamListItem(ctx, queue)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_tasks_queue.listCloudTasksQueues", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudTasksQueue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudTasksService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_tasks_queue.getCloudTasksQueue", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Queues.Get("projects/" + project + "/locations/" + location + "/queues/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_tasks_queue.getCloudTasksQueue", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getCloudTasksQueueIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	queue := h.Item.(*cloudtasks.Queue)
+
+	// Create Service Connection
+	service, err := CloudTasksService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_tasks_queue.getCloudTasksQueueIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Queues.GetIamPolicy(queue.Name, &cloudtasks.GetIamPolicyRequest{}).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_tasks_queue.getCloudTasksQueueIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
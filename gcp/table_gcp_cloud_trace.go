@@ -0,0 +1,295 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudtrace/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudTrace(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_trace",
+		Description: "GCP Cloud Trace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("trace_id"),
+			Hydrate:    getCloudTrace,
+			Tags:       map[string]string{"service": "cloudtrace", "action": "traces.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudTraces,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "start_time", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<="}},
+				{Name: "latency_ms", Require: plugin.Optional, Operators: []string{">", ">="}},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			Tags: map[string]string{"service": "cloudtrace", "action": "traces.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "trace_id",
+				Description: "Globally unique identifier for the trace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Trace.TraceId"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the root span of the trace, for example the URL of the traced request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RootSpan.Name"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the earliest span of the trace.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime").NullIfZero(),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end time of the latest span of the trace.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EndTime").NullIfZero(),
+			},
+			{
+				Name:        "latency_ms",
+				Description: "The overall latency of the trace, in milliseconds.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("LatencyMs"),
+			},
+			{
+				Name:        "span_count",
+				Description: "The number of spans of the trace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(cloudTraceSpanCount),
+			},
+			{
+				Name:        "filter",
+				Description: "The filter used to search the traces, for example +root:/api or latency:500ms. Refer to the Cloud Trace documentation for the filter syntax.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "root_span_labels",
+				Description: "The labels of the root span of the trace, for example /http/method or /http/status_code.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RootSpan.Labels"),
+			},
+			{
+				Name:        "spans",
+				Description: "Collection of spans in the trace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Trace.Spans"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Trace.TraceId"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(cloudTraceAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Trace.ProjectId"),
+			},
+		},
+	}
+}
+
+type cloudTraceRow struct {
+	Trace     *cloudtrace.Trace
+	RootSpan  *cloudtrace.TraceSpan
+	StartTime string
+	EndTime   string
+	LatencyMs float64
+}
+
+//// LIST FUNCTION
+
+func listCloudTraces(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudTraceService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_trace.listCloudTraces", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The complete view is required to get the spans of the traces
+	resp := service.Projects.Traces.List(project).View("COMPLETE").OrderBy("start desc").PageSize(*pageSize)
+
+	if d.Quals["start_time"] != nil {
+		for _, q := range d.Quals["start_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case "=":
+				resp.StartTime(timestamp).EndTime(timestamp)
+			case ">", ">=":
+				resp.StartTime(timestamp)
+			case "<", "<=":
+				resp.EndTime(timestamp)
+			}
+		}
+	}
+
+	if filter := buildCloudTraceFilter(d); filter != "" {
+		resp.Filter(filter)
+	}
+
+	if err := resp.Pages(ctx, func(page *cloudtrace.ListTracesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, trace := range page.Traces {
+			d.StreamListItem(ctx, newCloudTraceRow(trace))
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_trace.listCloudTraces", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudTrace(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	traceId := d.EqualsQualString("trace_id")
+
+	// Empty Check
+	if traceId == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudTraceService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_trace.getCloudTrace", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Traces.Get(project, traceId).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_cloud_trace.getCloudTrace", "api_error", err)
+		return nil, err
+	}
+
+	return newCloudTraceRow(resp), nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudTraceSpanCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return len(d.HydrateItem.(*cloudTraceRow).Trace.Spans), nil
+}
+
+func cloudTraceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	trace := d.HydrateItem.(*cloudTraceRow).Trace
+	return []string{"gcp://cloudtrace.googleapis.com/projects/" + trace.ProjectId + "/traces/" + trace.TraceId}, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The trace start and end times are those of its earliest and latest spans,
+// and the root span is the span without parent
+func newCloudTraceRow(trace *cloudtrace.Trace) *cloudTraceRow {
+	row := &cloudTraceRow{Trace: trace}
+
+	var startTime, endTime time.Time
+	for _, span := range trace.Spans {
+		if span.ParentSpanId == 0 && row.RootSpan == nil {
+			row.RootSpan = span
+		}
+		if spanStart, err := time.Parse(time.RFC3339Nano, span.StartTime); err == nil {
+			if startTime.IsZero() || spanStart.Before(startTime) {
+				startTime = spanStart
+				row.StartTime = span.StartTime
+			}
+		}
+		if spanEnd, err := time.Parse(time.RFC3339Nano, span.EndTime); err == nil {
+			if endTime.IsZero() || spanEnd.After(endTime) {
+				endTime = spanEnd
+				row.EndTime = span.EndTime
+			}
+		}
+	}
+
+	if !startTime.IsZero() && !endTime.IsZero() {
+		row.LatencyMs = float64(endTime.Sub(startTime).Microseconds()) / 1000
+	}
+
+	return row
+}
+
+// The terms of a trace filter are space separated and ANDed
+func buildCloudTraceFilter(d *plugin.QueryData) string {
+	filters := []string{}
+
+	if d.Quals["latency_ms"] != nil {
+		for _, q := range d.Quals["latency_ms"].Quals {
+			filters = append(filters, "latency:"+strconv.FormatFloat(q.Value.GetDoubleValue(), 'f', -1, 64)+"ms")
+		}
+	}
+
+	if filter := d.EqualsQualString("filter"); filter != "" {
+		filters = append(filters, filter)
+	}
+
+	return strings.Join(filters, " ")
+}
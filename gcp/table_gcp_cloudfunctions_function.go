@@ -12,6 +12,9 @@ import (
 	"google.golang.org/api/cloudfunctions/v2"
 )
 
+// Value substituted to environment variable values when redaction is enabled
+const redactedValue = "REDACTED"
+
 func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "gcp_cloudfunctions_function",
@@ -49,6 +52,24 @@ func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 				Description: "User-provided description of a function.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "generation",
+				Description: "The generation of the function (GEN_1 or GEN_2).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Environment"),
+			},
+			{
+				Name:        "trigger_type",
+				Description: "The type of trigger of the function (HTTP or EVENT).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudFunctionTriggerType),
+			},
+			{
+				Name:        "create_time",
+				Description: "The create timestamp of the Cloud Function.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
 			{
 				Name:        "runtime",
 				Description: "The runtime in which to run the function.",
@@ -69,6 +90,18 @@ func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("BuildConfig.EnvironmentVariables"),
 			},
+			{
+				Name:        "build_service_account",
+				Description: "The service account used by Cloud Build to build the function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BuildConfig.ServiceAccount"),
+			},
+			{
+				Name:        "docker_repository",
+				Description: "The Artifact Registry repository where the function docker image is stored.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BuildConfig.DockerRepository"),
+			},
 			{
 				Name:        "build_id",
 				Description: "The Cloud Build ID of the latest successful deployment of the function.",
@@ -331,6 +364,7 @@ func listCloudFunctions(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 			d.WaitForListRateLimit(ctx)
 
 			for _, item := range page.Functions {
+				redactCloudFunctionEnvironmentVariables(d, item)
 				d.StreamListItem(ctx, item)
 
 				// Check if context has been cancelled or if the limit has been hit (if specified)
@@ -375,6 +409,7 @@ func getCloudFunction(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	if err != nil {
 		return nil, err
 	}
+	redactCloudFunctionEnvironmentVariables(d, cloudFunction)
 
 	return cloudFunction, nil
 }
@@ -403,8 +438,37 @@ func getGcpCloudFunctionIamPolicy(ctx context.Context, d *plugin.QueryData, h *p
 	return cloudfunctions.Policy{}, nil
 }
 
+// redactCloudFunctionEnvironmentVariables masks the values of the build and
+// runtime environment variables when redact_environment_variables is set in
+// the connection config.
+func redactCloudFunctionEnvironmentVariables(d *plugin.QueryData, function *cloudfunctions.Function) {
+	config := GetConfig(d.Connection)
+	if config.RedactEnvironmentVariables == nil || !*config.RedactEnvironmentVariables {
+		return
+	}
+
+	if function.BuildConfig != nil {
+		for k := range function.BuildConfig.EnvironmentVariables {
+			function.BuildConfig.EnvironmentVariables[k] = redactedValue
+		}
+	}
+	if function.ServiceConfig != nil {
+		for k := range function.ServiceConfig.EnvironmentVariables {
+			function.ServiceConfig.EnvironmentVariables[k] = redactedValue
+		}
+	}
+}
+
 //// TRANSFORM FUNCTIONS
 
+func cloudFunctionTriggerType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	function := d.HydrateItem.(*cloudfunctions.Function)
+	if function.EventTrigger != nil {
+		return "EVENT", nil
+	}
+	return "HTTP", nil
+}
+
 func gcpCloudFunctionTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	function := d.HydrateItem.(*cloudfunctions.Function)
 	param := d.Param.(string)
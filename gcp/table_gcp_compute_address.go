@@ -116,6 +116,11 @@ func tableGcpComputeAddress(ctx context.Context) *plugin.Table {
 				Description: "A list of URLs of the resources that are using this address.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "labels",
+				Description: "Labels applied to this address.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// standard steampipe columns
 			{
@@ -124,6 +129,12 @@ func tableGcpComputeAddress(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
 			{
 				Name:        "akas",
 				Description: ColumnDescriptionAkas,
@@ -76,6 +76,41 @@ func tableGcpComputeBackendBucket(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("CdnPolicy.SignedUrlCacheMaxAgeSec"),
 			},
+			{
+				Name:        "cdn_policy_cache_mode",
+				Description: "Specifies the cache setting for all responses from this backend bucket (USE_ORIGIN_HEADERS, FORCE_CACHE_ALL or CACHE_ALL_STATIC).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CdnPolicy.CacheMode"),
+			},
+			{
+				Name:        "cdn_policy_default_ttl",
+				Description: "Specifies the default TTL, in seconds, for cached content served by this origin for responses that do not have an existing valid TTL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CdnPolicy.DefaultTtl"),
+			},
+			{
+				Name:        "cdn_policy_max_ttl",
+				Description: "Specifies the maximum allowed TTL, in seconds, for cached content served by this origin.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CdnPolicy.MaxTtl"),
+			},
+			{
+				Name:        "cdn_policy_client_ttl",
+				Description: "Specifies a separate client (e.g. browser client) maximum TTL, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CdnPolicy.ClientTtl"),
+			},
+			{
+				Name:        "cdn_policy_negative_caching",
+				Description: "Specifies whether negative caching is enabled, allowing per-status code TTLs to be set, in order to apply fine-grained caching for common errors or redirects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CdnPolicy.NegativeCaching"),
+			},
+			{
+				Name:        "edge_security_policy",
+				Description: "The resource URL for the edge security policy associated with this backend bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "self_link",
 				Description: "The server-defined URL for the resource.",
@@ -87,6 +122,11 @@ func tableGcpComputeBackendBucket(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("CdnPolicy.SignedUrlKeyNames"),
 			},
+			{
+				Name:        "cdn_policy",
+				Description: "Cloud CDN configuration for this backend bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// standard steampipe columns
 			{
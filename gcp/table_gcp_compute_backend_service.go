@@ -36,6 +36,12 @@ func tableGcpComputeBackendService(ctx context.Context) *plugin.Table {
 			},
 			Tags: map[string]string{"service": "compute", "action": "backendServices.list"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getComputeBackendServiceIapIamPolicy,
+				Tags: map[string]string{"service": "iap", "action": "v1.getIamPolicy"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "name",
@@ -137,6 +143,41 @@ func tableGcpComputeBackendService(ctx context.Context) *plugin.Table {
 				Description: "The resource URL for the security policy associated with this backend service.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "cdn_policy_cache_mode",
+				Description: "Specifies the cache setting for all responses from this backend service (USE_ORIGIN_HEADERS, FORCE_CACHE_ALL or CACHE_ALL_STATIC).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CdnPolicy.CacheMode"),
+			},
+			{
+				Name:        "cdn_policy_default_ttl",
+				Description: "Specifies the default TTL, in seconds, for cached content served by this origin for responses that do not have an existing valid TTL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CdnPolicy.DefaultTtl"),
+			},
+			{
+				Name:        "cdn_policy_max_ttl",
+				Description: "Specifies the maximum allowed TTL, in seconds, for cached content served by this origin.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CdnPolicy.MaxTtl"),
+			},
+			{
+				Name:        "cdn_policy_client_ttl",
+				Description: "Specifies a separate client (e.g. browser client) maximum TTL, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CdnPolicy.ClientTtl"),
+			},
+			{
+				Name:        "cdn_policy_negative_caching",
+				Description: "Specifies whether negative caching is enabled, allowing per-status code TTLs to be set, in order to apply fine-grained caching for common errors or redirects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CdnPolicy.NegativeCaching"),
+			},
+			{
+				Name:        "edge_security_policy",
+				Description: "The resource URL for the edge security policy associated with this backend service.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "self_link",
 				Description: "The server-defined URL for the resource.",
@@ -169,6 +210,11 @@ func tableGcpComputeBackendService(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("CdnPolicy.CacheKeyPolicy"),
 			},
+			{
+				Name:        "cdn_policy",
+				Description: "Cloud CDN configuration for this backend service.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "circuit_breakers",
 				Description: "Settings controlling the volume of connections to a backend service.",
@@ -189,6 +235,13 @@ func tableGcpComputeBackendService(ctx context.Context) *plugin.Table {
 				Description: "Specifies the configurations for Identity-Aware Proxy on this resource.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "iap_iam_policy",
+				Description: "The IAM policy of the Identity-Aware Proxy protecting the backend service, which specifies who can access it through IAP. Only available when IAP is enabled on the backend service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeBackendServiceIapIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "security_settings",
 				Description: "Specifies the security policy that applies to this backend service.",
@@ -348,6 +401,29 @@ func getComputeBackendService(ctx context.Context, d *plugin.QueryData, h *plugi
 	return &backendService, nil
 }
 
+func getComputeBackendServiceIapIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	backendService := h.Item.(*compute.BackendService)
+
+	// The IAP IAM policy is only available on the backend services protected by IAP
+	if backendService.Iap == nil || !backendService.Iap.Enabled {
+		return nil, nil
+	}
+
+	projectNumber, err := getIapProjectNumber(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_backend_service.getComputeBackendServiceIapIamPolicy", "project_error", err)
+		return nil, err
+	}
+
+	resp, err := getIapIamPolicy(ctx, d, iapBackendServiceResourceName(projectNumber.(string), backendService))
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_backend_service.getComputeBackendServiceIapIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func gcpComputeBackendServiceAka(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -78,6 +78,12 @@ func tableGcpComputeDisk(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.From(diskEncryptionKeyType),
 			},
+			{
+				Name:        "kms_key_name",
+				Description: "The name of the Cloud KMS key used to encrypt the disk, if the disk is protected by a customer-managed encryption key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiskEncryptionKey.KmsKeyName"),
+			},
 			{
 				Name:        "kind",
 				Description: "Type of the resource. Always compute#disk for disks.",
@@ -100,6 +106,31 @@ func tableGcpComputeDisk(ctx context.Context) *plugin.Table {
 				Description: "Physical block size of the persistent disk, in bytes. If not present in a request, a default value is used.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "provisioned_iops",
+				Description: "Indicates how many IOPS to provision for the disk. This sets the number of I/O operations per second that the disk can handle.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "provisioned_throughput",
+				Description: "Indicates how much throughput to provision for the disk, in MB per second.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "architecture",
+				Description: "The architecture of the disk. Valid values are ARM64 or X86_64.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_mode",
+				Description: "The access mode of the disk. Valid values are READ_WRITE_SINGLE, READ_WRITE_MANY and READ_ONLY_MANY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "storage_pool",
+				Description: "The storage pool in which the new disk is created.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "self_link",
 				Description: "Server-defined fully-qualified URL for this resource.",
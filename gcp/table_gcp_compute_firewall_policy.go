@@ -0,0 +1,224 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeFirewallPolicy(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_firewall_policy",
+		Description: "GCP Compute Hierarchical Firewall Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeFirewallPolicy,
+			Tags:       map[string]string{"service": "compute", "action": "firewallPolicies.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeFirewallPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent_id", Require: plugin.Required},
+			},
+			Tags: map[string]string{"service": "compute", "action": "firewallPolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. For hierarchical firewall policies, this is a server-generated numeric ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "short_name",
+				Description: "User-provided name of the organization firewall policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "Deprecated, please use short_name instead. User-provided name of the organization firewall policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent",
+				Description: "The parent of the firewall policy, in the form organizations/{organization_id} or folders/{folder_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_id",
+				Description: "The parent used to list the firewall policies, in the form organizations/{organization_id} or folders/{folder_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("parent_id"),
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#firewallPolicy for firewall policies.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fingerprint",
+				Description: "Fingerprint of the resource, used for optimistic locking.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_tuple_count",
+				Description: "Total count of all firewall policy rule tuples. A firewall policy can not exceed a set number of tuples.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link_with_id",
+				Description: "Server-defined URL for this resource with the resource id.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associations",
+				Description: "A list of associations that belong to this firewall policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rules",
+				Description: "A list of rules that belong to this policy, including priority, direction, action, match conditions and targets.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeFirewallPolicyTitle),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(computeFirewallPolicyAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeFirewallPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	parentId := d.EqualsQualString("parent_id")
+	if parentId == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_firewall_policy.listComputeFirewallPolicies", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.FirewallPolicies.List().ParentId(parentId).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.FirewallPolicyList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, policy := range page.Items {
+			d.StreamListItem(ctx, policy)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_firewall_policy.listComputeFirewallPolicies", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeFirewallPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_firewall_policy.getComputeFirewallPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.FirewallPolicies.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_firewall_policy.getComputeFirewallPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeFirewallPolicyTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy := d.HydrateItem.(*compute.FirewallPolicy)
+
+	if policy.ShortName != "" {
+		return policy.ShortName, nil
+	}
+	if policy.DisplayName != "" {
+		return policy.DisplayName, nil
+	}
+	return policy.Name, nil
+}
+
+func computeFirewallPolicyAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	return []string{"gcp://compute.googleapis.com/locations/global/firewallPolicies/" + name}, nil
+}
@@ -0,0 +1,210 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type firewallPolicyRuleInfo struct {
+	Rule           *compute.FirewallPolicyRule
+	FirewallPolicy *compute.FirewallPolicy
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeFirewallPolicyRule(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_firewall_policy_rule",
+		Description: "GCP Compute Hierarchical Firewall Policy Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeFirewallPolicies,
+			Hydrate:       listComputeFirewallPolicyRules,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent_id", Require: plugin.Required},
+				{Name: "firewall_policy_name", Require: plugin.Optional},
+				{Name: "direction", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "compute", "action": "firewallPolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "priority",
+				Description: "An integer indicating the priority of the rule. Rules are evaluated from the highest priority (lowest number) to the lowest priority.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Rule.Priority"),
+			},
+			{
+				Name:        "firewall_policy_name",
+				Description: "The name of the firewall policy the rule belongs to, a server-generated numeric ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FirewallPolicy.Name"),
+			},
+			{
+				Name:        "firewall_policy_short_name",
+				Description: "The user-provided name of the firewall policy the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FirewallPolicy.ShortName"),
+			},
+			{
+				Name:        "rule_name",
+				Description: "An optional name for the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.RuleName"),
+			},
+			{
+				Name:        "direction",
+				Description: "The direction of the traffic the rule applies to (INGRESS or EGRESS).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Direction"),
+			},
+			{
+				Name:        "action",
+				Description: "The action to perform when the rule is matched, one of allow, deny, goto_next or apply_security_profile_group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Action"),
+			},
+			{
+				Name:        "disabled",
+				Description: "True if the rule is disabled, in which case it is not enforced.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Rule.Disabled"),
+			},
+			{
+				Name:        "enable_logging",
+				Description: "True if the connections matched by the rule are logged.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Rule.EnableLogging"),
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Description"),
+			},
+			{
+				Name:        "rule_tuple_count",
+				Description: "The number of rule tuples used by the rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Rule.RuleTupleCount"),
+			},
+			{
+				Name:        "security_profile_group",
+				Description: "The security profile group the traffic is sent to, when the action is apply_security_profile_group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.SecurityProfileGroup").NullIfZero(),
+			},
+			{
+				Name:        "parent_id",
+				Description: "The parent used to list the firewall policies, in the form organizations/{organization_id} or folders/{folder_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("parent_id"),
+			},
+			{
+				Name:        "src_ip_ranges",
+				Description: "The source IP address ranges matched by the rule, for ingress rules.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.SrcIpRanges"),
+			},
+			{
+				Name:        "dest_ip_ranges",
+				Description: "The destination IP address ranges matched by the rule, for egress rules.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.DestIpRanges"),
+			},
+			{
+				Name:        "layer4_configs",
+				Description: "The protocols and ports matched by the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.Layer4Configs"),
+			},
+			{
+				Name:        "match",
+				Description: "The match condition of the rule, such as the source and destination IP ranges, FQDNs, region codes and address groups, and the protocols and ports.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match"),
+			},
+			{
+				Name:        "target_resources",
+				Description: "The VPC networks the rule applies to. If empty, the rule applies to all the networks below the node the policy is associated with.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.TargetResources"),
+			},
+			{
+				Name:        "target_service_accounts",
+				Description: "The service accounts of the instances the rule applies to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.TargetServiceAccounts"),
+			},
+			{
+				Name:        "target_secure_tags",
+				Description: "The secure tags of the instances the rule applies to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.TargetSecureTags"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(gcpComputeFirewallPolicyRuleTitle),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeFirewallPolicyRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	firewallPolicy := h.Item.(*compute.FirewallPolicy)
+
+	// Skip the firewall policies that do not match the given name
+	if d.EqualsQualString("firewall_policy_name") != "" && d.EqualsQualString("firewall_policy_name") != firewallPolicy.Name {
+		return nil, nil
+	}
+
+	// The rules are returned along with the firewall policy
+	for _, rule := range firewallPolicy.Rules {
+		if d.EqualsQualString("direction") != "" && d.EqualsQualString("direction") != rule.Direction {
+			continue
+		}
+
+		d.StreamListItem(ctx, &firewallPolicyRuleInfo{
+			Rule:           rule,
+			FirewallPolicy: firewallPolicy,
+		})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeFirewallPolicyRuleTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*firewallPolicyRuleInfo)
+	name := data.FirewallPolicy.ShortName
+	if name == "" {
+		name = data.FirewallPolicy.Name
+	}
+	return name + "/" + strconv.FormatInt(data.Rule.Priority, 10), nil
+}
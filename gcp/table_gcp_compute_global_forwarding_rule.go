@@ -152,6 +152,23 @@ func tableGcpComputeGlobalForwardingRule(ctx context.Context) *plugin.Table {
 				Description: "The URL of the target resource to receive the matched traffic.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "psc_connection_id",
+				Description: "The PSC connection id of the PSC forwarding rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PscConnectionId").NullIfZero(),
+			},
+			{
+				Name:        "psc_connection_status",
+				Description: "The status of the PSC connection of the forwarding rule (ACCEPTED, PENDING, REJECTED, CLOSED or NEEDS_ATTENTION).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PscConnectionStatus").NullIfZero(),
+			},
+			{
+				Name:        "no_automate_dns_zone",
+				Description: "Specifies whether the automatic creation of a private DNS zone is disabled for the PSC endpoint. Only valid for PSC endpoints targeting a service attachment.",
+				Type:        proto.ColumnType_BOOL,
+			},
 			{
 				Name:        "metadata_filters",
 				Description: "Opaque filter criteria used by Loadbalancer to restrict routing configuration to a limited set of xDS compliant clients.",
@@ -167,6 +184,11 @@ func tableGcpComputeGlobalForwardingRule(ctx context.Context) *plugin.Table {
 				Description: "A list of labels attached to this resource.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "service_directory_registrations",
+				Description: "The Service Directory namespace and service the PSC endpoint is registered with.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// standard steampipe columns
 			{
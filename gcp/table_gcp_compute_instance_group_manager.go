@@ -18,9 +18,17 @@ func tableGcpComputeInstanceGroupManager(ctx context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("name"),
 			Hydrate:    getComputeInstanceGroupManager,
+			Tags:       map[string]string{"service": "compute", "action": "instanceGroupManagers.aggregatedList"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeInstanceGroupManager,
+			Tags:    map[string]string{"service": "compute", "action": "instanceGroupManagers.aggregatedList"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getComputeInstanceGroupManagerAutoscaler,
+				Tags: map[string]string{"service": "compute", "action": "autoscalers.get"},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -146,6 +154,56 @@ func tableGcpComputeInstanceGroupManager(ctx context.Context) *plugin.Table {
 				Description: "The target number of running instances for this managed instance group.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "target_stopped_size",
+				Description: "The target number of stopped instances for this managed instance group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "target_suspended_size",
+				Description: "The target number of suspended instances for this managed instance group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "is_stable",
+				Description: "A bit indicating whether the managed instance group is in a stable state. A stable state means that none of the instances in the managed instance group is currently undergoing any type of change.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Status.IsStable"),
+			},
+			{
+				Name:        "autoscaler_name",
+				Description: "The name of the autoscaler that targets this instance group manager, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Autoscaler").Transform(lastPathElement),
+			},
+			{
+				Name:        "autoscaling_mode",
+				Description: "Defines the operating mode of the autoscaler. Possible values are ON, OFF, ONLY_SCALE_OUT and ONLY_UP.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeInstanceGroupManagerAutoscaler,
+				Transform:   transform.FromField("AutoscalingPolicy.Mode"),
+			},
+			{
+				Name:        "autoscaling_min_num_replicas",
+				Description: "The minimum number of replicas that the autoscaler can scale in to.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getComputeInstanceGroupManagerAutoscaler,
+				Transform:   transform.FromField("AutoscalingPolicy.MinNumReplicas"),
+			},
+			{
+				Name:        "autoscaling_max_num_replicas",
+				Description: "The maximum number of instances that the autoscaler can scale out to.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getComputeInstanceGroupManagerAutoscaler,
+				Transform:   transform.FromField("AutoscalingPolicy.MaxNumReplicas"),
+			},
+			{
+				Name:        "autoscaler",
+				Description: "The autoscaler that targets this instance group manager, including its autoscaling policy and status.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeInstanceGroupManagerAutoscaler,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "update_policy",
 				Description: "The update policy for this managed instance group.",
@@ -295,6 +353,39 @@ func getComputeInstanceGroupManager(ctx context.Context, d *plugin.QueryData, h
 	return &group, nil
 }
 
+func getComputeInstanceGroupManagerAutoscaler(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*compute.InstanceGroupManager)
+
+	// The instance group manager is not targeted by any autoscaler
+	if group.Status == nil || group.Status.Autoscaler == "" {
+		return nil, nil
+	}
+
+	project := strings.Split(group.SelfLink, "/")[6]
+	autoscalerName := getLastPathElement(group.Status.Autoscaler)
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_group_manager.getComputeInstanceGroupManagerAutoscaler", "service_creation_err", err)
+		return nil, err
+	}
+
+	var autoscaler *compute.Autoscaler
+	if zoneName := getLastPathElement(types.SafeString(group.Zone)); zoneName != "" {
+		autoscaler, err = service.Autoscalers.Get(project, zoneName, autoscalerName).Do()
+	} else {
+		regionName := getLastPathElement(types.SafeString(group.Region))
+		autoscaler, err = service.RegionAutoscalers.Get(project, regionName, autoscalerName).Do()
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_group_manager.getComputeInstanceGroupManagerAutoscaler", "api_err", err)
+		return nil, err
+	}
+
+	return autoscaler, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func instanceGroupManagerAka(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,335 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInterconnect(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_interconnect",
+		Description: "GCP Compute Interconnect",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeInterconnect,
+			Tags:       map[string]string{"service": "compute", "action": "interconnects.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeInterconnects,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "interconnect_type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "interconnects.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name that identifies the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "interconnect_type",
+				Description: "The type of the interconnect (DEDICATED or PARTNER).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the interconnect (ACTIVE or UNPROVISIONED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operational_status",
+				Description: "The current status of the interconnect functionality (OS_ACTIVE or OS_UNPROVISIONED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admin_enabled",
+				Description: "Administrative status of the interconnect. When false, no packets can be carried over the interconnect and no BGP routes are exchanged over it.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "link_type",
+				Description: "The type of the links in the interconnect bundle (LINK_TYPE_ETHERNET_10G_LR, LINK_TYPE_ETHERNET_100G_LR or LINK_TYPE_ETHERNET_400G_LR4).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_link_count",
+				Description: "The number of physical links requested by the customer for the interconnect bundle.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "provisioned_link_count",
+				Description: "The number of physical links actually provisioned in the interconnect bundle.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "macsec_enabled",
+				Description: "Specifies whether MACsec encryption is enabled for the interconnect.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "interconnect_location",
+				Description: "The URL of the interconnect location (colocation facility) where the interconnect exists.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location"),
+			},
+			{
+				Name:        "remote_location",
+				Description: "The URL of the remote location where the Cross-Cloud Interconnect connection is provisioned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_name",
+				Description: "The customer name, put in the Letter of Authorization as the party authorized to request a crossconnect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "noc_contact_email",
+				Description: "The email address to contact the customer NOC for operations and maintenance notifications.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "google_ip_address",
+				Description: "The IP address configured on the Google side of the interconnect link, used for link validation.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("GoogleIpAddress").NullIfZero(),
+			},
+			{
+				Name:        "peer_ip_address",
+				Description: "The IP address configured on the customer side of the interconnect link, used for link validation.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("PeerIpAddress").NullIfZero(),
+			},
+			{
+				Name:        "google_reference_id",
+				Description: "Google reference ID to be used when raising support tickets with Google or otherwise to debug backend connectivity issues.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the interconnect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The creation timestamp of the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "label_fingerprint",
+				Description: "A fingerprint for the labels being applied to this interconnect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "interconnect_attachments",
+				Description: "A list of the URLs of all the interconnect attachments that use this interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "circuit_infos",
+				Description: "A list of the circuit IDs of the physical links of the interconnect, along with the Google-side and customer-side demarc IDs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "expected_outages",
+				Description: "A list of the outages expected for the interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "macsec",
+				Description: "The MACsec configuration of the interconnect, such as the names and start times of the pre-shared keys.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "available_features",
+				Description: "The features available on the interconnect, for example IF_MACSEC.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "requested_features",
+				Description: "The features requested by the customer for the interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "A map of labels assigned by user.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeInterconnectTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeInterconnectTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInterconnects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect.listComputeInterconnects", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"interconnect_type", "interconnectType", "string"},
+		{"state", "state", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Interconnects.List(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.InterconnectList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, interconnect := range page.Items {
+			d.StreamListItem(ctx, interconnect)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect.listComputeInterconnects", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeInterconnect(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect.getComputeInterconnect", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Interconnects.Get(project, name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect.getComputeInterconnect", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeInterconnectTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	interconnect := d.HydrateItem.(*compute.Interconnect)
+	param := d.Param.(string)
+
+	project := strings.Split(interconnect.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Project": project,
+		"Akas":    []string{"gcp://compute.googleapis.com/projects/" + project + "/global/interconnects/" + interconnect.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -0,0 +1,365 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInterconnectAttachment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_interconnect_attachment",
+		Description: "GCP Compute Interconnect Attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeInterconnectAttachment,
+			Tags:       map[string]string{"service": "compute", "action": "interconnectAttachments.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeInterconnectAttachments,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "encryption", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "interconnectAttachments.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name that identifies the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the interconnect attachment (DEDICATED, PARTNER or PARTNER_PROVIDER).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the interconnect attachment, for example ACTIVE, PENDING_PARTNER or PENDING_CUSTOMER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operational_status",
+				Description: "The current status of whether or not the interconnect attachment is functional (OS_ACTIVE or OS_UNPROVISIONED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admin_enabled",
+				Description: "Determines whether the interconnect attachment can carry traffic.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "bandwidth",
+				Description: "The provisioned bandwidth capacity of the interconnect attachment, for example BPS_1G or BPS_10G.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vlan_tag_8021q",
+				Description: "The IEEE 802.1Q VLAN tag of the interconnect attachment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "encryption",
+				Description: "Indicates the user-supplied encryption option of the interconnect attachment (NONE or IPSEC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mtu",
+				Description: "Maximum Transmission Unit (MTU), in bytes, of packets passing through the interconnect attachment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "stack_type",
+				Description: "The stack type for the interconnect attachment (IPV4_ONLY or IPV4_IPV6).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edge_availability_domain",
+				Description: "The availability domain of the interconnect attachment (AVAILABILITY_DOMAIN_ANY, AVAILABILITY_DOMAIN_1 or AVAILABILITY_DOMAIN_2).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "interconnect",
+				Description: "The URL of the underlying interconnect object that the attachment's traffic will traverse through.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "router",
+				Description: "The URL of the Cloud Router to be used for dynamic routing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cloud_router_ip_address",
+				Description: "The IPv4 address and prefix length to be configured on the Cloud Router interface for the interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_router_ip_address",
+				Description: "The IPv4 address and prefix length to be configured on the customer router subinterface for the interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "partner_asn",
+				Description: "The optional BGP ASN for the router supplied by a Layer 3 partner, if they configured BGP on behalf of the customer.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "google_reference_id",
+				Description: "Google reference ID, to be used when raising support tickets with Google or otherwise to debug backend connectivity issues.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dataplane_version",
+				Description: "The dataplane version of the interconnect attachment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The creation timestamp of the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "label_fingerprint",
+				Description: "A fingerprint for the labels being applied to this interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the interconnect attachment resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "candidate_subnets",
+				Description: "Up to 16 candidate prefixes that can be used to restrict the allocation of the Cloud Router and customer router IP addresses.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ipsec_internal_addresses",
+				Description: "A list of URLs of addresses that have been reserved for the interconnect attachment, used only for HA VPN over Cloud Interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "partner_metadata",
+				Description: "Informational metadata about Partner attachments from Partners to display to customers, such as the partner name and portal URL.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "private_interconnect_info",
+				Description: "Information specific to an interconnect attachment, such as the 802.1q tag of the attachment on the interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "configuration_constraints",
+				Description: "The constraints of the configuration, such as the BGP MD5 authentication support and the allowed BGP peer ASN ranges.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "A map of labels assigned by user.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeInterconnectAttachmentTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeInterconnectAttachmentTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeInterconnectAttachmentTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInterconnectAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect_attachment.listComputeInterconnectAttachments", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"type", "type", "string"},
+		{"state", "state", "string"},
+		{"encryption", "encryption", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.InterconnectAttachments.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.InterconnectAttachmentAggregatedList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Items {
+			for _, attachment := range item.InterconnectAttachments {
+				d.StreamListItem(ctx, attachment)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect_attachment.listComputeInterconnectAttachments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeInterconnectAttachment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect_attachment.getComputeInterconnectAttachment", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var attachment compute.InterconnectAttachment
+
+	resp := service.InterconnectAttachments.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.InterconnectAttachmentAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.InterconnectAttachments {
+					attachment = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect_attachment.getComputeInterconnectAttachment", "api_error", err)
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(attachment.Name) < 1 {
+		return nil, nil
+	}
+
+	return &attachment, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeInterconnectAttachmentTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	attachment := d.HydrateItem.(*compute.InterconnectAttachment)
+	param := d.Param.(string)
+
+	region := getLastPathElement(types.SafeString(attachment.Region))
+	project := strings.Split(attachment.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Project":  project,
+		"Location": region,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + region + "/interconnectAttachments/" + attachment.Name},
+	}
+
+	return turbotData[param], nil
+}
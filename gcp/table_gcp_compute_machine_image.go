@@ -26,6 +26,12 @@ func tableGcpComputeMachineImage(ctx context.Context) *plugin.Table {
 			Hydrate: listComputeMachineImages,
 			Tags:    map[string]string{"service": "compute", "action": "machineImages.list"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getComputeMachineImageIamPolicy,
+				Tags: map[string]string{"service": "compute", "action": "machineImages.getIamPolicy"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "name",
@@ -90,6 +96,24 @@ func tableGcpComputeMachineImage(ctx context.Context) *plugin.Table {
 				Description: "Encrypts the machine image using a customer-supplied encryption key. After you encrypt a machine image using a customer-supplied key, you must provide the same key if you use the machine image later.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "kms_key_name",
+				Description: "The name of the Cloud KMS key used to encrypt the machine image, if it is protected by a customer-managed encryption key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MachineImageEncryptionKey.KmsKeyName"),
+			},
+			{
+				Name:        "source_instance_properties",
+				Description: "DEPRECATED: Please use instance_properties instead for source instance related properties.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources. A `Policy` is a collection of `bindings`. A `binding` binds one or more `members` to a single `role`. Members can be user accounts, service accounts, Google groups, and domains (such as G Suite). A `role` is a named list of permissions; each `role` can be an IAM predefined role or a user-created custom role. For some types of Google Cloud resources, a `binding` can also specify a `condition`, which is a logical expression that allows access to a resource only if the expression evaluates to `true`.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeMachineImageIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "saved_disks",
 				Description: "An array of Machine Image specific properties for disks attached to the source instance.",
@@ -121,6 +145,12 @@ func tableGcpComputeMachineImage(ctx context.Context) *plugin.Table {
 			},
 
 			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
 			{
 				Name:        "project",
 				Description: ColumnDescriptionProject,
@@ -217,6 +247,26 @@ func getComputeMachineImage(ctx context.Context, d *plugin.QueryData, h *plugin.
 	return resp, nil
 }
 
+func getComputeMachineImageIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_machine_image.getComputeMachineImageIamPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	machineImage := h.Item.(*compute.MachineImage)
+	project := strings.Split(machineImage.SelfLink, "/")[6]
+
+	resp, err := service.MachineImages.GetIamPolicy(project, machineImage.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_machine_image.getComputeMachineImageIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func machineImageTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
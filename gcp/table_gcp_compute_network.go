@@ -87,6 +87,26 @@ func tableGcpComputeNetwork(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("RoutingConfig.RoutingMode"),
 			},
+			{
+				Name:        "firewall_policy",
+				Description: "URL of the firewall policy the network is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_firewall_policy_enforcement_order",
+				Description: "The network firewall policy enforcement order. Can be either AFTER_CLASSIC_FIREWALL or BEFORE_CLASSIC_FIREWALL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_ula_internal_ipv6",
+				Description: "Enable ULA internal IPv6 on this network. Enabling this feature will assign a /48 from google defined ULA prefix fd20::/20.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "internal_ipv6_range",
+				Description: "When enabling ULA internal IPv6, caller optionally can specify the /48 range they want from the google defined ULA prefix fd20::/20.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "peerings",
 				Description: "A list of network peerings for the resource. NetworkPeering: A network peering attached to a network resource. The message includes the peering name, peer network, peering state, and a flag indicating whether Google Compute Engine should automatically create routes for the peering",
@@ -0,0 +1,308 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeNetworkEndpointGroup(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_network_endpoint_group",
+		Description: "GCP Compute Network Endpoint Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeNetworkEndpointGroup,
+			Tags:       map[string]string{"service": "compute", "action": "networkEndpointGroups.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeNetworkEndpointGroups,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "network_endpoint_type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "networkEndpointGroups.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name that identifies the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "network_endpoint_type",
+				Description: "Type of network endpoints in this network endpoint group, for example GCE_VM_IP_PORT, SERVERLESS, INTERNET_FQDN_PORT, NON_GCP_PRIVATE_IP_PORT or PRIVATE_SERVICE_CONNECT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A user-specified, human-readable description of the network endpoint group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The creation timestamp of the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "size",
+				Description: "The number of network endpoints in the network endpoint group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "default_port",
+				Description: "The default port used if the port number is not specified in the network endpoint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "network",
+				Description: "The URL of the network to which all network endpoints in the network endpoint group belong.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnetwork",
+				Description: "The URL of the subnetwork to which all network endpoints in the network endpoint group belong.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "psc_target_service",
+				Description: "The target service URL used to set up private service connection to a Google API or a PSC producer service attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone",
+				Description: "The URL of the zone where the network endpoint group is located, for zonal network endpoint groups.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the network endpoint group is located, for regional network endpoint groups.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "app_engine",
+				Description: "The target App Engine service, for serverless network endpoint groups.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_function",
+				Description: "The target Cloud Function, for serverless network endpoint groups.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_run",
+				Description: "The target Cloud Run service, for serverless network endpoint groups.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "psc_data",
+				Description: "The Private Service Connect data of the network endpoint group, such as the consumer PSC address and the connection status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "Metadata defined as annotations on the network endpoint group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location_type",
+				Description: "Location type where the network endpoint group resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Type"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeNetworkEndpointGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_network_endpoint_group.listComputeNetworkEndpointGroups", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"network_endpoint_type", "networkEndpointType", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.NetworkEndpointGroups.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.NetworkEndpointGroupAggregatedList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Items {
+			for _, networkEndpointGroup := range item.NetworkEndpointGroups {
+				d.StreamListItem(ctx, networkEndpointGroup)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_network_endpoint_group.listComputeNetworkEndpointGroups", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeNetworkEndpointGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_network_endpoint_group.getComputeNetworkEndpointGroup", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var networkEndpointGroup compute.NetworkEndpointGroup
+
+	resp := service.NetworkEndpointGroups.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.NetworkEndpointGroupAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.NetworkEndpointGroups {
+					networkEndpointGroup = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_network_endpoint_group.getComputeNetworkEndpointGroup", "api_error", err)
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(networkEndpointGroup.Name) < 1 {
+		return nil, nil
+	}
+
+	return &networkEndpointGroup, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeNetworkEndpointGroupLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	networkEndpointGroup := d.HydrateItem.(*compute.NetworkEndpointGroup)
+	param := d.Param.(string)
+
+	zoneName := getLastPathElement(types.SafeString(networkEndpointGroup.Zone))
+	regionName := getLastPathElement(types.SafeString(networkEndpointGroup.Region))
+	project := strings.Split(networkEndpointGroup.SelfLink, "/")[6]
+
+	locationData := map[string]interface{}{
+		"Type":     "GLOBAL",
+		"Location": "global",
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/global/networkEndpointGroups/" + networkEndpointGroup.Name},
+	}
+
+	if zoneName != "" {
+		locationData["Type"] = "ZONAL"
+		locationData["Location"] = zoneName
+		locationData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/zones/" + zoneName + "/networkEndpointGroups/" + networkEndpointGroup.Name}
+	} else if regionName != "" {
+		locationData["Type"] = "REGIONAL"
+		locationData["Location"] = regionName
+		locationData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/networkEndpointGroups/" + networkEndpointGroup.Name}
+	}
+
+	return locationData[param], nil
+}
@@ -101,6 +101,27 @@ func tableGcpComputeNodeGroup(ctx context.Context) *plugin.Table {
 				Description: "The URL of the node template to create the node group from.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "maintenance_interval",
+				Description: "Specifies the frequency of planned maintenance events. Valid values are AS_NEEDED and RECURRENT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "maintenance_window",
+				Description: "The maintenance window of the node group, including its start time and duration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "share_settings",
+				Description: "Share-settings for the node group. Specifies whether the node group is shared with other projects and which projects it is shared with.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "share_type",
+				Description: "Type of sharing for this node group. Valid values are LOCAL, ORGANIZATION and SPECIFIC_PROJECTS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ShareSettings.ShareType"),
+			},
 			{
 				Name:        "self_link",
 				Description: "The server-defined URL for the resource.",
@@ -124,6 +124,16 @@ func tableGcpComputeNodeTemplate(ctx context.Context) *plugin.Table {
 				Description: "A list of labels to use for node affinity, which will be used in instance scheduling.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "accelerators",
+				Description: "A list of accelerator cards (type and count) attached to each node created from this template.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "disks",
+				Description: "A list of local disks (type, size and count) attached to each node created from this template.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "iam_policy",
 				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources. A `Policy` is a collection of `bindings`. A `binding` binds one or more `members` to a single `role`. Members can be user accounts, service accounts, Google groups, and domains (such as G Suite). A `role` is a named list of permissions; each `role` can be an IAM predefined role or a user-created custom role. For some types of Google Cloud resources, a `binding` can also specify a `condition`, which is a logical expression that allows access to a resource only if the expression evaluates to `true`.",
@@ -0,0 +1,288 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeReservation(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_reservation",
+		Description: "GCP Compute Reservation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeReservation,
+			Tags:       map[string]string{"service": "compute", "action": "reservations.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeReservations,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+
+				// Boolean columns
+				{Name: "specific_reservation_required", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "reservations.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource, provided by the client when initially creating the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the reservation. Possible values are CREATING, DELETING, INVALID, READY and UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "commitment",
+				Description: "Full or partial URL to a parent commitment. This field displays for reservations that are tied to a commitment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "specific_reservation_required",
+				Description: "Indicates whether the reservation can be consumed by VMs with affinity for any reservation, or only by VMs that target this reservation by name.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "machine_type",
+				Description: "The machine type of the instances reserved by this reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SpecificReservation.InstanceProperties.MachineType"),
+			},
+			{
+				Name:        "count",
+				Description: "Specifies the number of resources that are allocated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SpecificReservation.Count"),
+			},
+			{
+				Name:        "in_use_count",
+				Description: "Indicates how many instances are in use.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SpecificReservation.InUseCount"),
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#reservations for reservations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined fully-qualified URL for this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone",
+				Description: "Zone in which the reservation resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "specific_reservation",
+				Description: "Reservation for instances with specific machine shapes, including the instance properties and counts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "aggregate_reservation",
+				Description: "Reservation for aggregated resources, providing shape flexibility.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "share_settings",
+				Description: "Specify share-settings to create a shared reservation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_policies",
+				Description: "Resource policies to be added to this reservation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_status",
+				Description: "Status information for the reservation resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeReservationTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(lastPathElement),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeReservationTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeReservations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_reservation.listComputeReservations", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"status", "status", "string"},
+		{"specific_reservation_required", "specificReservationRequired", "boolean"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api/compute/v1#ReservationsAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Reservations.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.ReservationAggregatedList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Items {
+			for _, reservation := range item.Reservations {
+				d.StreamListItem(ctx, reservation)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_reservation.listComputeReservations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeReservation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_reservation.getComputeReservation", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var reservation compute.Reservation
+	resp := service.Reservations.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.ReservationAggregatedList) error {
+		for _, item := range page.Items {
+			for _, i := range item.Reservations {
+				reservation = *i
+			}
+		}
+		return nil
+	},
+	); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_reservation.getComputeReservation", "api_error", err)
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(reservation.Name) < 1 {
+		return nil, nil
+	}
+
+	return &reservation, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeReservationTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	reservation := d.HydrateItem.(*compute.Reservation)
+	param := d.Param.(string)
+
+	zone := getLastPathElement(types.SafeString(reservation.Zone))
+	project := strings.Split(reservation.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Project": project,
+		"Akas":    []string{"gcp://compute.googleapis.com/projects/" + project + "/zones/" + zone + "/reservations/" + reservation.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -27,6 +27,12 @@ func tableGcpComputeRouter(ctx context.Context) *plugin.Table {
 			Hydrate: listComputeRouters,
 			Tags:    map[string]string{"service": "compute", "action": "routers.list"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getComputeRouterStatus,
+				Tags: map[string]string{"service": "compute", "action": "routers.getRouterStatus"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "name",
@@ -102,6 +108,20 @@ func tableGcpComputeRouter(ctx context.Context) *plugin.Table {
 				Description: "A list of NAT services created in this router.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "bgp_peer_status",
+				Description: "The runtime status of the BGP sessions of this router, including the session state, uptime and the number of learned routes.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeRouterStatus,
+				Transform:   transform.FromField("BgpPeerStatus"),
+			},
+			{
+				Name:        "nat_status",
+				Description: "The runtime status of the NAT services of this router, including the allocated external IPs and the number of VM endpoints using NAT.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeRouterStatus,
+				Transform:   transform.FromField("NatStatus"),
+			},
 
 			// standard steampipe columns
 			{
@@ -232,6 +252,28 @@ func getComputeRouter(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return &router, nil
 }
 
+func getComputeRouterStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	router := h.Item.(*compute.Router)
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_router.getComputeRouterStatus", "service_error", err)
+		return nil, err
+	}
+
+	region := getLastPathElement(types.SafeString(router.Region))
+	project := strings.Split(router.SelfLink, "/")[6]
+
+	resp, err := service.Routers.GetRouterStatus(project, region, router.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_router.getComputeRouterStatus", "api_error", err)
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func gcpComputeRouterTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
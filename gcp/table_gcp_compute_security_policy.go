@@ -0,0 +1,317 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSecurityPolicy(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_security_policy",
+		Description: "GCP Compute Security Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeSecurityPolicy,
+			Tags:       map[string]string{"service": "compute", "action": "securityPolicies.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeSecurityPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "securityPolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name that identifies the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the security policy (CLOUD_ARMOR, CLOUD_ARMOR_EDGE or CLOUD_ARMOR_NETWORK). Edge security policies filter the requests before they are served from the Cloud CDN cache.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A user-specified, human-readable description of the security policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The creation timestamp of the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "adaptive_protection_enabled",
+				Description: "Specifies whether the Adaptive Protection layer 7 DDoS defense is enabled for the security policy, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AdaptiveProtectionConfig.Layer7DdosDefenseConfig.Enable"),
+			},
+			{
+				Name:        "rule_count",
+				Description: "The number of rules of the security policy, including the default rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(gcpComputeSecurityPolicyRuleCount),
+			},
+			{
+				Name:        "fingerprint",
+				Description: "An unique system generated string, to reduce conflicts when multiple users change any property of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the regional security policy resides. Empty for global security policies.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "adaptive_protection_config",
+				Description: "The Adaptive Protection configuration of the security policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "advanced_options_config",
+				Description: "The advanced options of the security policy, such as the JSON parsing and the log level.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ddos_protection_config",
+				Description: "The network DDoS protection configuration of the security policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recaptcha_options_config",
+				Description: "The reCAPTCHA configuration of the security policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rules",
+				Description: "A list of rules that belong to this policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_defined_fields",
+				Description: "The user defined fields that can be used in the rules of network security policies.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "A map of labels assigned by user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location_type",
+				Description: "Location type where the security policy resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Type"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSecurityPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_security_policy.listComputeSecurityPolicies", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"type", "type", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.SecurityPolicies.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.SecurityPoliciesAggregatedList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Items {
+			for _, securityPolicy := range item.SecurityPolicies {
+				d.StreamListItem(ctx, securityPolicy)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_security_policy.listComputeSecurityPolicies", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeSecurityPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_security_policy.getComputeSecurityPolicy", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var securityPolicy compute.SecurityPolicy
+
+	resp := service.SecurityPolicies.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.SecurityPoliciesAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.SecurityPolicies {
+					securityPolicy = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_security_policy.getComputeSecurityPolicy", "api_error", err)
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(securityPolicy.Name) < 1 {
+		return nil, nil
+	}
+
+	return &securityPolicy, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeSecurityPolicyRuleCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	securityPolicy := d.HydrateItem.(*compute.SecurityPolicy)
+	return len(securityPolicy.Rules), nil
+}
+
+func gcpComputeSecurityPolicyLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var securityPolicy *compute.SecurityPolicy
+	switch item := d.HydrateItem.(type) {
+	case *compute.SecurityPolicy:
+		securityPolicy = item
+	case *securityPolicyRuleInfo:
+		securityPolicy = item.SecurityPolicy
+	}
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(securityPolicy.Region))
+	project := strings.Split(securityPolicy.SelfLink, "/")[6]
+
+	locationData := map[string]interface{}{
+		"Type":     "REGIONAL",
+		"Location": regionName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/securityPolicies/" + securityPolicy.Name},
+	}
+
+	if regionName == "" {
+		locationData["Type"] = "GLOBAL"
+		locationData["Location"] = "global"
+		locationData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/global/securityPolicies/" + securityPolicy.Name}
+	}
+
+	return locationData[param], nil
+}
@@ -0,0 +1,192 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type securityPolicyRuleInfo struct {
+	Rule           *compute.SecurityPolicyRule
+	SecurityPolicy *compute.SecurityPolicy
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSecurityPolicyRule(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_security_policy_rule",
+		Description: "GCP Compute Security Policy Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeSecurityPolicies,
+			Hydrate:       listComputeSecurityPolicyRules,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "security_policy_name", Require: plugin.Optional},
+				{Name: "security_policy_type", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "compute", "action": "securityPolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "priority",
+				Description: "An integer indicating the priority of the rule. Rules are evaluated from the highest priority (lowest number) to the lowest priority; the default rule has the priority 2147483647.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Rule.Priority"),
+			},
+			{
+				Name:        "security_policy_name",
+				Description: "The name of the security policy the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityPolicy.Name"),
+			},
+			{
+				Name:        "security_policy_type",
+				Description: "The type of the security policy the rule belongs to (CLOUD_ARMOR, CLOUD_ARMOR_EDGE or CLOUD_ARMOR_NETWORK).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityPolicy.Type"),
+			},
+			{
+				Name:        "action",
+				Description: "The action to perform when the rule is matched, for example allow, deny(403), rate_based_ban, redirect or throttle.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Action"),
+			},
+			{
+				Name:        "preview",
+				Description: "If true, the specified action is not enforced, and the matched requests are only logged.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Rule.Preview"),
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Description"),
+			},
+			{
+				Name:        "match_expression",
+				Description: "The Common Expression Language expression of the rule, for example evaluatePreconfiguredWaf('sqli-v33-stable').",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Match.Expr.Expression"),
+			},
+			{
+				Name:        "versioned_expr",
+				Description: "The preconfigured versioned expression of the rule. If set to SRC_IPS_V1, the src_ip_ranges field must be specified.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Match.VersionedExpr"),
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Kind"),
+			},
+			{
+				Name:        "src_ip_ranges",
+				Description: "The source IP address ranges matched by the rule, when the versioned expression SRC_IPS_V1 is used.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.Config.SrcIpRanges"),
+			},
+			{
+				Name:        "match",
+				Description: "A match condition that incoming traffic is evaluated against.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match"),
+			},
+			{
+				Name:        "network_match",
+				Description: "A match condition that incoming packets are evaluated against, for network security policies.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.NetworkMatch"),
+			},
+			{
+				Name:        "preconfigured_waf_config",
+				Description: "The preconfigured WAF configuration to be applied for the rule, such as the excluded signatures.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.PreconfiguredWafConfig"),
+			},
+			{
+				Name:        "rate_limit_options",
+				Description: "The rate limiting options of the rule, for rate_based_ban and throttle actions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.RateLimitOptions"),
+			},
+			{
+				Name:        "redirect_options",
+				Description: "The redirect options of the rule, for redirect actions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.RedirectOptions"),
+			},
+			{
+				Name:        "header_action",
+				Description: "The request headers added to the request by the rule before it is forwarded to the backend.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.HeaderAction"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(gcpComputeSecurityPolicyRuleTitle),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSecurityPolicyRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	securityPolicy := h.Item.(*compute.SecurityPolicy)
+
+	// Skip the security policies that do not match the given name or type
+	if d.EqualsQualString("security_policy_name") != "" && d.EqualsQualString("security_policy_name") != securityPolicy.Name {
+		return nil, nil
+	}
+	if d.EqualsQualString("security_policy_type") != "" && d.EqualsQualString("security_policy_type") != securityPolicy.Type {
+		return nil, nil
+	}
+
+	// The rules are returned along with the security policy
+	for _, rule := range securityPolicy.Rules {
+		d.StreamListItem(ctx, &securityPolicyRuleInfo{
+			Rule:           rule,
+			SecurityPolicy: securityPolicy,
+		})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeSecurityPolicyRuleTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*securityPolicyRuleInfo)
+	return data.SecurityPolicy.Name + "/" + strconv.FormatInt(data.Rule.Priority, 10), nil
+}
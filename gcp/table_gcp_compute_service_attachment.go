@@ -0,0 +1,301 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeServiceAttachment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_service_attachment",
+		Description: "GCP Compute Service Attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeServiceAttachment,
+			Tags:       map[string]string{"service": "compute", "action": "serviceAttachments.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeServiceAttachments,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "connection_preference", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "serviceAttachments.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name that identifies the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "connection_preference",
+				Description: "The connection preference of the service attachment (ACCEPT_AUTOMATIC or ACCEPT_MANUAL).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_service",
+				Description: "The URL of the service, usually a producer forwarding rule, that is being published through the service attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_proxy_protocol",
+				Description: "Specifies whether the PROXY protocol is enabled, to provide the consumer connection information to the producer.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "reconcile_connections",
+				Description: "Specifies whether the existing connections are updated when the consumer accept or reject lists are changed.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "propagated_connection_limit",
+				Description: "The number of consumer spokes that connected Private Service Connect endpoints can be propagated to through Network Connectivity Center.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "connected_endpoint_count",
+				Description: "The number of consumer endpoints connected to the service attachment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(gcpComputeServiceAttachmentConnectedEndpointCount),
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the service attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The creation timestamp of the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "fingerprint",
+				Description: "An unique system generated string, to reduce conflicts when multiple users change any property of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the service attachment resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "nat_subnets",
+				Description: "The URLs of the subnetworks used to NAT the consumer connections, which must have the PRIVATE_SERVICE_CONNECT purpose.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "consumer_accept_lists",
+				Description: "The projects or networks that are allowed to connect to the service attachment, along with their connection limit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "consumer_reject_lists",
+				Description: "The projects that are not allowed to connect to the service attachment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "connected_endpoints",
+				Description: "The consumer endpoints connected to the service attachment, along with their network and connection status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domain_names",
+				Description: "The domain names of the service attachment, used to create the DNS entries of the consumer endpoints.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "psc_service_attachment_id",
+				Description: "A 128-bit unique identifier of the service attachment, used by the consumer to connect.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeServiceAttachmentTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeServiceAttachmentTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeServiceAttachmentTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeServiceAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_service_attachment.listComputeServiceAttachments", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"connection_preference", "connectionPreference", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.ServiceAttachments.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.ServiceAttachmentAggregatedList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Items {
+			for _, serviceAttachment := range item.ServiceAttachments {
+				d.StreamListItem(ctx, serviceAttachment)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_service_attachment.listComputeServiceAttachments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeServiceAttachment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_service_attachment.getComputeServiceAttachment", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var serviceAttachment compute.ServiceAttachment
+
+	resp := service.ServiceAttachments.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.ServiceAttachmentAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.ServiceAttachments {
+					serviceAttachment = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_service_attachment.getComputeServiceAttachment", "api_error", err)
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(serviceAttachment.Name) < 1 {
+		return nil, nil
+	}
+
+	return &serviceAttachment, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeServiceAttachmentConnectedEndpointCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	serviceAttachment := d.HydrateItem.(*compute.ServiceAttachment)
+	return len(serviceAttachment.ConnectedEndpoints), nil
+}
+
+func gcpComputeServiceAttachmentTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	serviceAttachment := d.HydrateItem.(*compute.ServiceAttachment)
+	param := d.Param.(string)
+
+	region := getLastPathElement(types.SafeString(serviceAttachment.Region))
+	project := strings.Split(serviceAttachment.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Project":  project,
+		"Location": region,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + region + "/serviceAttachments/" + serviceAttachment.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -26,6 +26,7 @@ func tableGcpComputeSnapshot(ctx context.Context) *plugin.Table {
 				// String columns
 				{Name: "status", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "storage_bytes_status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "snapshot_type", Require: plugin.Optional, Operators: []string{"<>", "="}},
 
 				// Boolean columns
 				{Name: "auto_created", Require: plugin.Optional, Operators: []string{"<>", "="}},
@@ -39,6 +40,11 @@ func tableGcpComputeSnapshot(ctx context.Context) *plugin.Table {
 				Description: "Name of the resource; provided by the client when the resource is created.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
 			{
 				Name:        "source_disk",
 				Description: "The url of the source disk used to create this snapshot.",
@@ -51,6 +57,31 @@ func tableGcpComputeSnapshot(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("SourceDisk").Transform(lastPathElement),
 			},
+			{
+				Name:        "source_disk_id",
+				Description: "The ID value of the disk used to create this snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_type",
+				Description: "Indicates the type of the snapshot. Valid values are STANDARD and ARCHIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "architecture",
+				Description: "The architecture of the snapshot. Valid values are ARM64 or X86_64.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "chain_name",
+				Description: "Creates the new snapshot in the snapshot chain labeled with the specified name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_snapshot_schedule_policy",
+				Description: "URL of the resource policy which created this scheduled snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "description",
 				Description: "An optional description of this resource.",
@@ -71,6 +102,11 @@ func tableGcpComputeSnapshot(ctx context.Context) *plugin.Table {
 				Description: "Size of the source disk, specified in GB.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "creation_size_bytes",
+				Description: "Size in bytes of the snapshot at creation time.",
+				Type:        proto.ColumnType_INT,
+			},
 			{
 				Name:        "download_bytes",
 				Description: "Number of bytes downloaded to restore a snapshot to a disk.",
@@ -101,6 +137,12 @@ func tableGcpComputeSnapshot(ctx context.Context) *plugin.Table {
 				Description: "An indicator whether storageBytes is in a stable state or it is being adjusted as a result of shared storage reallocation. This status can either be UPDATING, meaning the size of the snapshot is being updated, or UP_TO_DATE, meaning the size of the snapshot is up-to-date.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "snapshot_encryption_key_type",
+				Description: "The type of encryption key used to encrypt the snapshot. Valid values are Google managed | Customer managed | Customer supplied.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(snapshotEncryptionKeyType),
+			},
 			{
 				Name:        "kms_key_name",
 				Description: "The name of the encryption key that is used to encrypt snapshot",
@@ -199,6 +241,7 @@ func listComputeSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	filterQuals := []filterQualMap{
 		{"status", "status", "string"},
 		{"storage_bytes_status", "storageBytesStatus", "string"},
+		{"snapshot_type", "snapshotType", "string"},
 		{"auto_created", "autoCreated", "boolean"},
 	}
 
@@ -300,3 +343,16 @@ func gcpComputeSnapshotTurbotData(_ context.Context, d *transform.TransformData)
 
 	return turbotData[param], nil
 }
+
+func snapshotEncryptionKeyType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	snapshot := d.HydrateItem.(*compute.Snapshot)
+
+	if snapshot.SnapshotEncryptionKey == nil {
+		return "Google managed", nil
+	} else if len(snapshot.SnapshotEncryptionKey.KmsKeyName) > 0 {
+		return "Customer managed", nil
+	} else if len(snapshot.SnapshotEncryptionKey.Sha256) > 0 {
+		return "Customer supplied", nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,289 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSslCertificate(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_ssl_certificate",
+		Description: "GCP Compute SSL Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeSslCertificate,
+			Tags:       map[string]string{"service": "compute", "action": "sslCertificates.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeSslCertificates,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "compute", "action": "sslCertificates.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "Specifies the type of SSL certificate, either SELF_MANAGED or MANAGED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Specifies the time when the resource is created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "expire_time",
+				Description: "Expire time of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#sslCertificate for SSL certificates.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_status",
+				Description: "Status of the managed certificate resource. Possible values are ACTIVE, PROVISIONING, PROVISIONING_FAILED, PROVISIONING_FAILED_PERMANENTLY and RENEWAL_FAILED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.Status"),
+			},
+			{
+				Name:        "managed_domains",
+				Description: "The domains for which a managed SSL certificate will be generated.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.Domains"),
+			},
+			{
+				Name:        "managed_domain_status",
+				Description: "Detailed statuses of the domains specified for managed certificate resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.DomainStatus"),
+			},
+			{
+				Name:        "subject_alternative_names",
+				Description: "Domains associated with the certificate via Subject Alternative Name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "certificate",
+				Description: "A value read into memory from a certificate file. The certificate file must be in PEM format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "URL of the region where the regional SSL certificate resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location_type",
+				Description: "Location type where the SSL certificate resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSslCertificateLocation, "Type"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(gcpComputeSslCertificateAka),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSslCertificateLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSslCertificateLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSslCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssl_certificate.listComputeSslCertificates", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"type", "type", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api/compute/v1#SslCertificatesAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.SslCertificates.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.SslCertificateAggregatedList) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Items {
+			for _, sslCertificate := range item.SslCertificates {
+				d.StreamListItem(ctx, sslCertificate)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssl_certificate.listComputeSslCertificates", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeSslCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssl_certificate.getComputeSslCertificate", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var sslCertificate compute.SslCertificate
+	resp := service.SslCertificates.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.SslCertificateAggregatedList) error {
+		for _, item := range page.Items {
+			for _, i := range item.SslCertificates {
+				sslCertificate = *i
+			}
+		}
+		return nil
+	},
+	); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssl_certificate.getComputeSslCertificate", "api_error", err)
+		return nil, err
+	}
+
+	if len(sslCertificate.Name) < 1 {
+		return nil, nil
+	}
+
+	return &sslCertificate, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeSslCertificateAka(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.SslCertificate)
+	region := getLastPathElement(types.SafeString(data.Region))
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	akas := []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + region + "/sslCertificates/" + data.Name}
+
+	if region == "" {
+		akas = []string{"gcp://compute.googleapis.com/projects/" + project + "/global/sslCertificates/" + data.Name}
+	}
+
+	return akas, nil
+}
+
+func gcpComputeSslCertificateLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.SslCertificate)
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(data.Region))
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	locationData := map[string]string{
+		"Type":     "REGIONAL",
+		"Location": regionName,
+		"Project":  project,
+	}
+
+	if regionName == "" {
+		locationData["Type"] = "GLOBAL"
+		locationData["Location"] = "global"
+	}
+
+	return locationData[param], nil
+}
@@ -32,6 +32,9 @@ func tableGcpComputeSubnetwork(ctx context.Context) *plugin.Table {
 				// Boolean columns
 				{Name: "enable_flow_logs", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "private_ip_google_access", Require: plugin.Optional, Operators: []string{"<>", "="}},
+
+				// Region of the subnetwork, used to list a single region instead of aggregating all of them
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
 			},
 			Tags: map[string]string{"service": "compute", "action": "subnetworks.list"},
 		},
@@ -165,6 +168,26 @@ func tableGcpComputeSubnetwork(ctx context.Context) *plugin.Table {
 				Description: "Specifies the role of the subnetwork.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "stack_type",
+				Description: "The stack type for the subnet. If set to IPV4_ONLY, new VMs in the subnet are assigned IPv4 addresses only. If set to IPV4_IPV6, new VMs in the subnet can be assigned both IPv4 and IPv6 addresses.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ipv6_access_type",
+				Description: "The access type of IPv6 address this subnet holds. Possible values are EXTERNAL and INTERNAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "internal_ipv6_prefix",
+				Description: "The internal IPv6 address range that is owned by this subnetwork.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_ipv6_prefix",
+				Description: "The external IPv6 address range that is owned by this subnetwork.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "self_link",
 				Description: "Server-defined URL for the resource.",
@@ -263,6 +286,32 @@ func listComputeSubnetworks(ctx context.Context, d *plugin.QueryData, h *plugin.
 	}
 	project := projectId.(string)
 
+	// List a single region when the location is specified
+	region := d.EqualsQualString("location")
+	if region != "" {
+		resp := service.Subnetworks.List(project, region).Filter(filterString).MaxResults(*pageSize)
+		if err := resp.Pages(ctx, func(page *compute.SubnetworkList) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, subnetwork := range page.Items {
+				d.StreamListItem(ctx, subnetwork)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
 	resp := service.Subnetworks.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
 	if err := resp.Pages(ctx, func(page *compute.SubnetworkAggregatedList) error {
 		// apply rate limiting
@@ -0,0 +1,254 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/containeranalysis/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpContainerAnalysisNote(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_container_analysis_note",
+		Description: "GCP Container Analysis Note",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getContainerAnalysisNote,
+			Tags:       map[string]string{"service": "containeranalysis", "action": "notes.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listContainerAnalysisNotes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "kind", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "containeranalysis", "action": "notes.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the note.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "kind",
+				Description: "The type of analysis the note describes, for example VULNERABILITY, ATTESTATION, BUILD, IMAGE, PACKAGE, DEPLOYMENT, DISCOVERY or SBOM_REFERENCE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "short_description",
+				Description: "A one sentence description of the note.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "long_description",
+				Description: "A detailed description of the note.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the note, in the form projects/{project_id}/notes/{note_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the note was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the note was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "expiration_time",
+				Description: "The time of expiration for the note, if any.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpirationTime").NullIfZero(),
+			},
+			{
+				Name:        "related_note_names",
+				Description: "Other notes related to the note.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "related_url",
+				Description: "URLs associated with the note.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vulnerability",
+				Description: "A note describing a package vulnerability.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "attestation",
+				Description: "A note describing an attestation role.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "build",
+				Description: "A note describing build provenance for a verifiable build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image",
+				Description: "A note describing a base image.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "package",
+				Description: "A note describing a package hosted by various package managers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deployment",
+				Description: "A note describing something that can be deployed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "discovery",
+				Description: "A note describing the initial analysis of a resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sbom_reference",
+				Description: "A note describing an SBOM reference.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "compliance",
+				Description: "A note describing a compliance check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "upgrade",
+				Description: "A note describing available package upgrades.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "containeranalysis.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listContainerAnalysisNotes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ContainerAnalysisService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_note.listContainerAnalysisNotes", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Notes.List("projects/" + project).PageSize(*pageSize)
+	if kind := d.EqualsQualString("kind"); kind != "" {
+		resp = resp.Filter("kind=\"" + kind + "\"")
+	}
+	if err := resp.Pages(ctx, func(page *containeranalysis.ListNotesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, note := range page.Notes {
+			d.StreamListItem(ctx, note)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_note.listContainerAnalysisNotes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getContainerAnalysisNote(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ContainerAnalysisService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_note.getContainerAnalysisNote", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Notes.Get("projects/" + project + "/notes/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_note.getContainerAnalysisNote", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
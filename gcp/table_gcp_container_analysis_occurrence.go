@@ -0,0 +1,283 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/containeranalysis/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpContainerAnalysisOccurrence(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_container_analysis_occurrence",
+		Description: "GCP Container Analysis Occurrence",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getContainerAnalysisOccurrence,
+			Tags:       map[string]string{"service": "containeranalysis", "action": "occurrences.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listContainerAnalysisOccurrences,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "kind", Require: plugin.Optional},
+				{Name: "resource_uri", Require: plugin.Optional},
+				{Name: "note_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "containeranalysis", "action": "occurrences.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the occurrence.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "kind",
+				Description: "The type of analysis the occurrence describes, for example VULNERABILITY, ATTESTATION, BUILD, IMAGE, PACKAGE, DEPLOYMENT, DISCOVERY or SBOM_REFERENCE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_uri",
+				Description: "A URI that represents the resource the occurrence is for, for example https://gcr.io/project/image@sha256:123abc for a Docker image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "note_name",
+				Description: "The analysis note associated with the occurrence, in the form projects/{project_id}/notes/{note_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The note provider assigned severity of the vulnerability. Only set for vulnerability occurrences.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vulnerability.Severity"),
+			},
+			{
+				Name:        "effective_severity",
+				Description: "The distro or language system assigned severity of the vulnerability. Only set for vulnerability occurrences.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vulnerability.EffectiveSeverity"),
+			},
+			{
+				Name:        "cvss_score",
+				Description: "The CVSS score of the vulnerability. Only set for vulnerability occurrences.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Vulnerability.CvssScore"),
+			},
+			{
+				Name:        "fix_available",
+				Description: "Whether at least one of the affected packages has a fix available. Only set for vulnerability occurrences.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Vulnerability.FixAvailable"),
+			},
+			{
+				Name:        "remediation",
+				Description: "A description of actions that can be taken to remedy the note.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the occurrence, in the form projects/{project_id}/occurrences/{occurrence_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the occurrence was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the occurrence was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "vulnerability",
+				Description: "Describes a security vulnerability.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "attestation",
+				Description: "Describes an attestation of an artifact.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "build",
+				Description: "Describes a verifiable build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image",
+				Description: "Describes how the image was built from its base image.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "package",
+				Description: "Describes the installation of a package on the linked resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deployment",
+				Description: "Describes the deployment of an artifact on a runtime.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "discovery",
+				Description: "Describes when a resource was discovered.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sbom_reference",
+				Description: "Describes a specific SBOM reference occurrence.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "compliance",
+				Description: "Describes a compliance violation on a linked resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "upgrade",
+				Description: "Describes an available package upgrade on the linked resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "containeranalysis.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listContainerAnalysisOccurrences(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ContainerAnalysisService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_occurrence.listContainerAnalysisOccurrences", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// https://cloud.google.com/artifact-analysis/docs/investigate-vulnerabilities#filter
+	filters := []string{}
+	if kind := d.EqualsQualString("kind"); kind != "" {
+		filters = append(filters, fmt.Sprintf("kind=\"%s\"", kind))
+	}
+	if resourceUri := d.EqualsQualString("resource_uri"); resourceUri != "" {
+		filters = append(filters, fmt.Sprintf("resourceUrl=\"%s\"", resourceUri))
+	}
+	if noteName := d.EqualsQualString("note_name"); noteName != "" {
+		parts := strings.Split(noteName, "/")
+		if len(parts) == 4 {
+			filters = append(filters, fmt.Sprintf("noteProjectId=\"%s\" AND noteId=\"%s\"", parts[1], parts[3]))
+		}
+	}
+
+	resp := service.Projects.Occurrences.List("projects/" + project).Filter(strings.Join(filters, " AND ")).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *containeranalysis.ListOccurrencesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, occurrence := range page.Occurrences {
+			d.StreamListItem(ctx, occurrence)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_occurrence.listContainerAnalysisOccurrences", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getContainerAnalysisOccurrence(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ContainerAnalysisService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_occurrence.getContainerAnalysisOccurrence", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Occurrences.Get("projects/" + project + "/occurrences/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_container_analysis_occurrence.getContainerAnalysisOccurrence", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,343 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/datafusion/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpDataFusionInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_datafusion_instance",
+		Description: "GCP Data Fusion Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getDataFusionInstance,
+			Tags:       map[string]string{"service": "datafusion", "action": "instances.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataFusionInstances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "datafusion", "action": "instances.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "Display name for the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The edition of the instance (BASIC, ENTERPRISE or DEVELOPER).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "Current version of the Data Fusion.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "patch_revision",
+				Description: "Current patch revision of the Data Fusion.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the instance (CREATING, ACTIVE, FAILED, DELETING, UPGRADING, RESTARTING, UPDATING, AUTO_UPDATING, AUTO_UPGRADING, DISABLED or ENABLING).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_message",
+				Description: "Additional information about the current state of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "private_instance",
+				Description: "Specifies whether the Data Fusion instance should be private. If set to true, all Data Fusion nodes will have private IP addresses and will not be able to access the public internet.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "network",
+				Description: "Name of the network in the customer project with which the tenant project will be peered for executing pipelines.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkConfig.Network"),
+			},
+			{
+				Name:        "ip_allocation",
+				Description: "The IP range in CIDR notation to use for the managed Data Fusion instance nodes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkConfig.IpAllocation"),
+			},
+			{
+				Name:        "connection_type",
+				Description: "The type of connection to make to the customer network (VPC_PEERING or PRIVATE_SERVICE_CONNECT_INTERFACES).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkConfig.ConnectionType"),
+			},
+			{
+				Name:        "enable_rbac",
+				Description: "Whether role-based access control is enabled for the instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_stackdriver_logging",
+				Description: "Whether Cloud Logging is enabled for the instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_stackdriver_monitoring",
+				Description: "Whether Cloud Monitoring is enabled for the instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_zone_separation",
+				Description: "Whether the instance uses zone separation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "crypto_key_name",
+				Description: "The name of the customer-managed encryption key used to protect the resources of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CryptoKeyConfig.KeyReference"),
+			},
+			{
+				Name:        "service_endpoint",
+				Description: "Endpoint on which the Data Fusion UI is accessible.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_endpoint",
+				Description: "Endpoint on which the REST APIs is accessible.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gcs_bucket",
+				Description: "Cloud Storage bucket generated by Data Fusion in the customer project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dataproc_service_account",
+				Description: "User-managed service account to set on Dataproc when Cloud Data Fusion creates Dataproc to run data processing pipelines.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "p4_service_account",
+				Description: "Service agent for the customer project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_project_id",
+				Description: "The name of the tenant project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone",
+				Description: "Name of the zone in which the Data Fusion instance will be created. Only DEVELOPER instances use this field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the instance was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "accelerators",
+				Description: "List of accelerators enabled for this Data Fusion instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_config",
+				Description: "Network configuration options. These are required when a private Data Fusion instance is to be created.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "available_version",
+				Description: "Available versions that the instance can be upgraded to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "disabled_reason",
+				Description: "If the instance state is DISABLED, the reason for disabling the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "event_publish_config",
+				Description: "Option to enable and pass metadata for event publishing.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "maintenance_policy",
+				Description: "Configure the maintenance policy for this instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "options",
+				Description: "Map of additional options used to configure the behavior of Data Fusion instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The resource labels for instance to use to annotate any related underlying resources such as Compute Engine VMs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName", "Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "datafusion.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataFusionInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := DataFusionService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_datafusion_instance.listDataFusionInstances", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all locations at once
+		// https://cloud.google.com/data-fusion/docs/reference/rest/v1/projects.locations.instances/list
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Instances.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *datafusion.ListInstancesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, instance := range page.Instances {
+			d.StreamListItem(ctx, instance)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_datafusion_instance.listDataFusionInstances", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataFusionInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := DataFusionService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_datafusion_instance.getDataFusionInstance", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Instances.Get("projects/" + project + "/locations/" + location + "/instances/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_datafusion_instance.getDataFusionInstance", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -50,6 +50,90 @@ func tableGcpDataprocCluster(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Status.State"),
 			},
+			{
+				Name:        "image_version",
+				Description: "The version of the software inside the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.SoftwareConfig.ImageVersion"),
+			},
+			{
+				Name:        "master_machine_type",
+				Description: "The Compute Engine machine type used for the master instances of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.MasterConfig.MachineTypeUri").Transform(lastPathElement).NullIfZero(),
+			},
+			{
+				Name:        "master_num_instances",
+				Description: "The number of master instances in the cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Config.MasterConfig.NumInstances"),
+			},
+			{
+				Name:        "worker_machine_type",
+				Description: "The Compute Engine machine type used for the primary worker instances of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.WorkerConfig.MachineTypeUri").Transform(lastPathElement).NullIfZero(),
+			},
+			{
+				Name:        "worker_num_instances",
+				Description: "The number of primary worker instances in the cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Config.WorkerConfig.NumInstances"),
+			},
+			{
+				Name:        "secondary_worker_num_instances",
+				Description: "The number of secondary worker instances in the cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Config.SecondaryWorkerConfig.NumInstances"),
+			},
+			{
+				Name:        "autoscaling_policy",
+				Description: "The autoscaling policy used by the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.AutoscalingConfig.PolicyUri"),
+			},
+			{
+				Name:        "internal_ip_only",
+				Description: "If true, all instances in the cluster will only have internal IP addresses.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Config.GceClusterConfig.InternalIpOnly"),
+			},
+			{
+				Name:        "service_account",
+				Description: "The Dataproc service account used by the Compute Engine VMs of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.GceClusterConfig.ServiceAccount"),
+			},
+			{
+				Name:        "subnetwork_uri",
+				Description: "The Compute Engine subnetwork to be used for machine communications.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.GceClusterConfig.SubnetworkUri"),
+			},
+			{
+				Name:        "kerberos_enabled",
+				Description: "Whether Kerberos is enabled on the cluster.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Config.SecurityConfig.KerberosConfig.EnableKerberos"),
+			},
+			{
+				Name:        "gce_pd_kms_key_name",
+				Description: "The Cloud KMS key resource name to use for persistent disk encryption for all instances in the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.EncryptionConfig.GcePdKmsKeyName"),
+			},
+			{
+				Name:        "http_port_access_enabled",
+				Description: "If true, enable http access to specific ports on the cluster from external sources.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Config.EndpointConfig.EnableHttpPortAccess"),
+			},
+			{
+				Name:        "kerberos_config",
+				Description: "Kerberos related configuration of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Config.SecurityConfig.KerberosConfig"),
+			},
 			{
 				Name:        "config",
 				Description: "The cluster config.",
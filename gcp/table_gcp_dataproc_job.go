@@ -0,0 +1,355 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/dataproc/v1"
+)
+
+func tableGcpDataprocJob(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_dataproc_job",
+		Description: "GCP Dataproc Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("job_id"),
+			Hydrate:    getDataprocJob,
+			Tags:       map[string]string{"service": "dataproc", "action": "jobs.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataprocJobs,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "cluster_name", Require: plugin.Optional, Operators: []string{"="}},
+			},
+			Tags: map[string]string{"service": "dataproc", "action": "jobs.list"},
+		},
+		GetMatrixItemFunc: BuildComputeLocationList,
+		Columns: []*plugin.Column{
+			// commonly used columns
+			{
+				Name:        "job_id",
+				Description: "The job ID, which must be unique within the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reference.JobId"),
+			},
+			{
+				Name:        "job_uuid",
+				Description: "A UUID that uniquely identifies a job within the project over time.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_type",
+				Description: "The type of the job (HADOOP, SPARK, PYSPARK, HIVE, PIG, SPARK_R, SPARK_SQL, PRESTO, TRINO or FLINK).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(dataprocJobType),
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster where the job will be submitted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Placement.ClusterName"),
+			},
+			{
+				Name:        "cluster_uuid",
+				Description: "A cluster UUID generated by the Dataproc service when the job is submitted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Placement.ClusterUuid"),
+			},
+			{
+				Name:        "state",
+				Description: "The job's state.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.State"),
+			},
+			{
+				Name:        "state_start_time",
+				Description: "The time when this state was entered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Status.StateStartTime").NullIfZero(),
+			},
+			{
+				Name:        "status_details",
+				Description: "Optional job state details, such as an error description if the state is ERROR.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Details"),
+			},
+			{
+				Name:        "done",
+				Description: "Indicates whether the job is completed.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "driver_output_resource_uri",
+				Description: "A URI pointing to the location of the stdout of the job's driver program.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "driver_control_files_uri",
+				Description: "If present, the location of miscellaneous control files which can be used as part of job setup and handling.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_config",
+				Description: "The configuration of the job, which depends on its type.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(dataprocJobConfig),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels to associate with this job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "placement",
+				Description: "Dataproc job config.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scheduling",
+				Description: "Job scheduling configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status",
+				Description: "The job status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status_history",
+				Description: "The previous job status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "yarn_applications",
+				Description: "The collection of YARN applications spun up by this job.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reference.JobId"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     gcpDataprocJobTurbotData,
+				Transform:   transform.FromField("Akas"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     gcpDataprocJobTurbotData,
+				Transform:   transform.FromField("Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reference.ProjectId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataprocJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Create Service Connection
+	service, err := DataprocService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dataproc_job.listDataprocJobs", "connection_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Regions.Jobs.List(project, location).PageSize(*pageSize)
+	if d.EqualsQualString("cluster_name") != "" {
+		resp = resp.ClusterName(d.EqualsQualString("cluster_name"))
+	}
+	if err := resp.Pages(ctx, func(page *dataproc.ListJobsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, job := range page.Jobs {
+			d.StreamListItem(ctx, job)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_dataproc_job.listDataprocJobs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataprocJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	jobId := d.EqualsQualString("job_id")
+
+	if len(jobId) < 1 {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := DataprocService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dataproc_job.getDataprocJob", "connection_error", err)
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Regions.Jobs.Get(project, location, jobId).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dataproc_job.getDataprocJob", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func gcpDataprocJobTurbotData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	job := h.Item.(*dataproc.Job)
+
+	var project, jobId string
+	if job.Reference != nil {
+		project = job.Reference.ProjectId
+		jobId = job.Reference.JobId
+	}
+
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	turbotData := map[string]interface{}{
+		"Project":  project,
+		"Location": location,
+		"Akas":     []string{"gcp://dataproc.googleapis.com/projects/" + project + "/regions/" + location + "/jobs/" + jobId},
+	}
+
+	return turbotData, nil
+}
+
+func dataprocJobType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	job := d.HydrateItem.(*dataproc.Job)
+
+	switch {
+	case job.HadoopJob != nil:
+		return "HADOOP", nil
+	case job.SparkJob != nil:
+		return "SPARK", nil
+	case job.PysparkJob != nil:
+		return "PYSPARK", nil
+	case job.HiveJob != nil:
+		return "HIVE", nil
+	case job.PigJob != nil:
+		return "PIG", nil
+	case job.SparkRJob != nil:
+		return "SPARK_R", nil
+	case job.SparkSqlJob != nil:
+		return "SPARK_SQL", nil
+	case job.PrestoJob != nil:
+		return "PRESTO", nil
+	case job.TrinoJob != nil:
+		return "TRINO", nil
+	case job.FlinkJob != nil:
+		return "FLINK", nil
+	}
+	return nil, nil
+}
+
+func dataprocJobConfig(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	job := d.HydrateItem.(*dataproc.Job)
+
+	switch {
+	case job.HadoopJob != nil:
+		return job.HadoopJob, nil
+	case job.SparkJob != nil:
+		return job.SparkJob, nil
+	case job.PysparkJob != nil:
+		return job.PysparkJob, nil
+	case job.HiveJob != nil:
+		return job.HiveJob, nil
+	case job.PigJob != nil:
+		return job.PigJob, nil
+	case job.SparkRJob != nil:
+		return job.SparkRJob, nil
+	case job.SparkSqlJob != nil:
+		return job.SparkSqlJob, nil
+	case job.PrestoJob != nil:
+		return job.PrestoJob, nil
+	case job.TrinoJob != nil:
+		return job.TrinoJob, nil
+	case job.FlinkJob != nil:
+		return job.FlinkJob, nil
+	}
+	return nil, nil
+}
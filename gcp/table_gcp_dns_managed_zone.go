@@ -74,6 +74,12 @@ func tableGcpDnsManagedZone(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DnssecConfig.State"),
 			},
+			{
+				Name:        "cloud_logging_enabled",
+				Description: "If true, DNS queries for the zone are logged to Cloud Logging.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CloudLoggingConfig.EnableLogging"),
+			},
 			{
 				Name:        "name_server_set",
 				Description: "Specifies the NameServerSet for this ManagedZone. A NameServerSet is a set of DNS name servers that all host the same ManagedZones.",
@@ -85,6 +91,12 @@ func tableGcpDnsManagedZone(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("ServiceDirectoryConfig.Namespace.DeletionTime").Transform(transform.NullIfZeroValue),
 			},
+			{
+				Name:        "service_directory_config_namespace_url",
+				Description: "The fully qualified URL of the Service Directory namespace that backs this zone, in the format https://servicedirectory.googleapis.com/v1/projects/{project}/locations/{location}/namespaces/{namespace}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceDirectoryConfig.Namespace.NamespaceUrl"),
+			},
 			{
 				Name:        "self_link",
 				Description: "Server-defined URL for the managed zone.",
@@ -116,6 +128,12 @@ func tableGcpDnsManagedZone(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("PrivateVisibilityConfig.Networks"),
 			},
+			{
+				Name:        "private_visibility_config_gke_clusters",
+				Description: "The list of Google Kubernetes Engine clusters that can see the zone.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateVisibilityConfig.GkeClusters"),
+			},
 			{
 				Name:        "labels",
 				Description: "A set labels attached with the resource.",
@@ -29,7 +29,10 @@ func tableDnsRecordSet(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate:       listDnsRecordSets,
 			ParentHydrate: listDnsManagedZones,
-			Tags:          map[string]string{"service": "dns", "action": "resourceRecordSets.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "managed_zone_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "dns", "action": "resourceRecordSets.list"},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -121,6 +124,11 @@ func listDnsRecordSets(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	// Get the details of Cloud DNS Managed Zone
 	managedZone := h.Item.(*dns.ManagedZone)
 
+	// Minimize the API call with the given managed zone name
+	if d.EqualsQualString("managed_zone_name") != "" && d.EqualsQualString("managed_zone_name") != managedZone.Name {
+		return nil, nil
+	}
+
 	// Create Service Connection
 	service, err := DnsService(ctx, d)
 	if err != nil {
@@ -145,16 +153,24 @@ func listDnsRecordSets(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	}
 	project := projectId.(string)
 
-	resp, err := service.ResourceRecordSets.List(project, managedZone.Name).MaxResults(*pageSize).Do()
-	// apply rate limiting
-	d.WaitForListRateLimit(ctx)
+	resp := service.ResourceRecordSets.List(project, managedZone.Name).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *dns.ResourceRecordSetsListResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
 
-	if err != nil {
-		return nil, err
-	}
+		for _, recordset := range page.Rrsets {
+			d.StreamListItem(ctx, recordSetInfo{recordset, managedZone.Name})
 
-	for _, recordset := range resp.Rrsets {
-		d.StreamListItem(ctx, recordSetInfo{recordset, managedZone.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	return nil, nil
@@ -181,13 +197,18 @@ func getDnsRecordSet(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 
 	name := d.EqualsQuals["name"].GetStringValue()
 	rrset_type := d.EqualsQuals["type"].GetStringValue()
-	managedZoneName := d.EqualsQuals["managedzone_name"].GetStringValue()
+	managedZoneName := d.EqualsQuals["managed_zone_name"].GetStringValue()
 
 	resp, err := service.ResourceRecordSets.List(project, managedZoneName).Name(name).Type(rrset_type).Do()
 	if err != nil {
 		return nil, err
 	}
 
+	// The API returns an empty list if the record set does not exist
+	if len(resp.Rrsets) < 1 {
+		return nil, nil
+	}
+
 	return recordSetInfo{resp.Rrsets[0], managedZoneName}, nil
 }
 
@@ -0,0 +1,201 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/dns/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableDnsResponsePolicy(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_dns_response_policy",
+		Description: "GCP DNS Response Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getDnsResponsePolicy,
+			Tags:       map[string]string{"service": "dns", "action": "responsePolicies.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDnsResponsePolicies,
+			Tags:    map[string]string{"service": "dns", "action": "responsePolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "User assigned name for the response policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponsePolicyName"),
+			},
+			{
+				Name:        "id",
+				Description: "Unique identifier for the resource; defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "description",
+				Description: "User-provided description for the response policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "networks",
+				Description: "List of network names specifying networks to which the response policy applies.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "gke_clusters",
+				Description: "The list of Google Kubernetes Engine clusters to which the response policy applies.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "User labels.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResponsePolicyName"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDnsResponsePolicyAka,
+				Transform:   transform.FromValue(),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDnsResponsePolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := DnsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy.listDnsResponsePolicies", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.ResponsePolicies.List(project).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *dns.ResponsePoliciesListResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, responsePolicy := range page.ResponsePolicies {
+			d.StreamListItem(ctx, responsePolicy)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy.listDnsResponsePolicies", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDnsResponsePolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := DnsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy.getDnsResponsePolicy", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.ResponsePolicies.Get(project, name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy.getDnsResponsePolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getDnsResponsePolicyAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	responsePolicy := h.Item.(*dns.ResponsePolicy)
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	akas := []string{"gcp://dns.googleapis.com/projects/" + project + "/responsePolicies/" + responsePolicy.ResponsePolicyName}
+	return akas, nil
+}
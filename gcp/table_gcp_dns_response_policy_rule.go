@@ -0,0 +1,211 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/dns/v1"
+)
+
+type responsePolicyRuleInfo = struct {
+	Rule               *dns.ResponsePolicyRule
+	ResponsePolicyName string
+}
+
+//// TABLE DEFINITION
+
+func tableDnsResponsePolicyRule(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_dns_response_policy_rule",
+		Description: "GCP DNS Response Policy Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"response_policy_name", "name"}),
+			Hydrate:    getDnsResponsePolicyRule,
+			Tags:       map[string]string{"service": "dns", "action": "responsePolicyRules.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listDnsResponsePolicyRules,
+			ParentHydrate: listDnsResponsePolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "response_policy_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "dns", "action": "responsePolicyRules.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "An identifier for the rule, unique within the response policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.RuleName"),
+			},
+			{
+				Name:        "response_policy_name",
+				Description: "The name of the response policy the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dns_name",
+				Description: "The DNS name (wildcard or exact) to apply the rule to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.DnsName"),
+			},
+			{
+				Name:        "behavior",
+				Description: "Answer this query with a behavior rather than DNS data, for example bypassResponsePolicy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Behavior").NullIfZero(),
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Kind"),
+			},
+			{
+				Name:        "local_data",
+				Description: "The DNS records returned instead of the query results, when the rule answers queries with local data.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.LocalData.LocalDatas"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.RuleName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDnsResponsePolicyRuleAka,
+				Transform:   transform.FromValue(),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDnsResponsePolicyRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	responsePolicy := h.Item.(*dns.ResponsePolicy)
+
+	// Minimize the API call with the given response policy name
+	if d.EqualsQualString("response_policy_name") != "" && d.EqualsQualString("response_policy_name") != responsePolicy.ResponsePolicyName {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := DnsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy_rule.listDnsResponsePolicyRules", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.ResponsePolicyRules.List(project, responsePolicy.ResponsePolicyName).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *dns.ResponsePolicyRulesListResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, rule := range page.ResponsePolicyRules {
+			d.StreamListItem(ctx, responsePolicyRuleInfo{rule, responsePolicy.ResponsePolicyName})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy_rule.listDnsResponsePolicyRules", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDnsResponsePolicyRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	responsePolicyName := d.EqualsQualString("response_policy_name")
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if responsePolicyName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := DnsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy_rule.getDnsResponsePolicyRule", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.ResponsePolicyRules.Get(project, responsePolicyName, name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_dns_response_policy_rule.getDnsResponsePolicyRule", "api_error", err)
+		return nil, err
+	}
+
+	return responsePolicyRuleInfo{resp, responsePolicyName}, nil
+}
+
+func getDnsResponsePolicyRuleAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(responsePolicyRuleInfo)
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	akas := []string{"gcp://dns.googleapis.com/projects/" + project + "/responsePolicies/" + data.ResponsePolicyName + "/rules/" + data.Rule.RuleName}
+	return akas, nil
+}
@@ -0,0 +1,193 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/essentialcontacts/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpEssentialContact(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_essential_contact",
+		Description: "GCP Essential Contact",
+		List: &plugin.ListConfig{
+			Hydrate: listEssentialContacts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "folder_id", Require: plugin.Optional},
+				{Name: "organization_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "essentialcontacts", "action": "contacts.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the contact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "email",
+				Description: "The email address the notifications are sent to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "validation_state",
+				Description: "The validity of the contact (VALID or INVALID). A contact becomes invalid when its email address is found to be unreachable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "validate_time",
+				Description: "The last time the validation state was updated. A contact is considered stale if it was not validated for more than a year.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ValidateTime").NullIfZero(),
+			},
+			{
+				Name:        "language_tag",
+				Description: "The preferred language of the notifications, as an ISO 639-1 language code.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent",
+				Description: "The resource the contact is defined on, in the form projects/{project_number}, folders/{folder_id} or organizations/{organization_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(essentialContactParent),
+			},
+			{
+				Name:        "folder_id",
+				Description: "The ID of the folder to list the contacts of, instead of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("folder_id"),
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization to list the contacts of, instead of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the contact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "notification_category_subscriptions",
+				Description: "The categories of notifications the contact receives, for example SECURITY, BILLING, TECHNICAL or ALL.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Email"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "essentialcontacts.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// The contacts of the project are listed by default, the ones of a folder or
+// of an organization are listed when its ID is given
+func listEssentialContacts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := EssentialContactService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_essential_contact.listEssentialContacts", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	listContacts := func(page *essentialcontacts.GoogleCloudEssentialcontactsV1ListContactsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, contact := range page.Contacts {
+			d.StreamListItem(ctx, contact)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	folderId := d.EqualsQualString("folder_id")
+	organizationId := d.EqualsQualString("organization_id")
+
+	switch {
+	case folderId != "":
+		err = service.Folders.Contacts.List("folders/"+folderId).PageSize(*pageSize).Pages(ctx, listContacts)
+	case organizationId != "":
+		err = service.Organizations.Contacts.List("organizations/"+organizationId).PageSize(*pageSize).Pages(ctx, listContacts)
+	default:
+		// Get project details
+		projectId, projectErr := getProject(ctx, d, h)
+		if projectErr != nil {
+			return nil, projectErr
+		}
+		project := projectId.(string)
+
+		err = service.Projects.Contacts.List("projects/"+project).PageSize(*pageSize).Pages(ctx, listContacts)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_essential_contact.listEssentialContacts", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Contact names have the form {parent_type}/{parent_id}/contacts/{contact_id}
+func essentialContactParent(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 2 {
+		return nil, nil
+	}
+	return parts[0] + "/" + parts[1], nil
+}
@@ -0,0 +1,222 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/eventarc/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpEventarcChannel(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_eventarc_channel",
+		Description: "GCP Eventarc Channel",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getEventarcChannel,
+			Tags:       map[string]string{"service": "eventarc", "action": "channels.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEventarcChannels,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "eventarc", "action": "channels.list"},
+		},
+		GetMatrixItemFunc: BuildEventarcLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the channel.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "uid",
+				Description: "Server assigned unique identifier for the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the channel (PENDING, ACTIVE or INACTIVE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provider",
+				Description: "The name of the event provider (e.g. Eventarc SaaS partner) associated with the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pubsub_topic",
+				Description: "The name of the Pub/Sub topic created and managed by Eventarc system as a transport for the event delivery.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "crypto_key_name",
+				Description: "The resource name of a KMS crypto key used to encrypt or decrypt the event data associated with the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation time of the channel.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last-modified time of the channel.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Whether or not this channel satisfies the requirements of physical zone separation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "labels",
+				Description: "Resource labels attached to the channel.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "eventarc.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listEventarcChannels(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := EventarcService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_channel.listEventarcChannels", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Channels.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *eventarc.ListChannelsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, channel := range page.Channels {
+			d.StreamListItem(ctx, channel)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_channel.listEventarcChannels", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEventarcChannel(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := EventarcService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_channel.getEventarcChannel", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Channels.Get("projects/" + project + "/locations/" + location + "/channels/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_channel.getEventarcChannel", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
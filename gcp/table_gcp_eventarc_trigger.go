@@ -0,0 +1,327 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/eventarc/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpEventarcTrigger(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_eventarc_trigger",
+		Description: "GCP Eventarc Trigger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getEventarcTrigger,
+			Tags:       map[string]string{"service": "eventarc", "action": "triggers.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEventarcTriggers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "eventarc", "action": "triggers.list"},
+		},
+		GetMatrixItemFunc: BuildEventarcLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the trigger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "uid",
+				Description: "Server assigned unique identifier for the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of the events matched by the trigger, taken from its event filter on the `type` attribute.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventFilters").Transform(eventarcTriggerEventType),
+			},
+			{
+				Name:        "destination_type",
+				Description: "The type of the destination of the trigger (CLOUD_RUN, CLOUD_FUNCTION, GKE, WORKFLOW or HTTP_ENDPOINT).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination").Transform(eventarcTriggerDestinationType),
+			},
+			{
+				Name:        "destination_cloud_run_service",
+				Description: "The name of the Cloud Run service events are sent to, for Cloud Run destinations.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.CloudRun.Service"),
+			},
+			{
+				Name:        "destination_cloud_function",
+				Description: "The resource name of the Cloud Function events are sent to, for Cloud Functions destinations.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.CloudFunction"),
+			},
+			{
+				Name:        "destination_workflow",
+				Description: "The resource name of the Workflow events are sent to, for Workflows destinations.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.Workflow"),
+			},
+			{
+				Name:        "destination_http_endpoint_uri",
+				Description: "The URI of the HTTP endpoint events are sent to, for HTTP endpoint destinations.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.HttpEndpoint.Uri"),
+			},
+			{
+				Name:        "transport_pubsub_topic",
+				Description: "The name of the Pub/Sub topic used to transport events to the destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transport.Pubsub.Topic"),
+			},
+			{
+				Name:        "transport_pubsub_subscription",
+				Description: "The name of the Pub/Sub subscription created and managed by Eventarc to transport events to the destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Transport.Pubsub.Subscription"),
+			},
+			{
+				Name:        "service_account",
+				Description: "The IAM service account email associated with the trigger. The service account represents the identity of the trigger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "channel",
+				Description: "The name of the channel associated with the trigger, for triggers on third-party events.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_data_content_type",
+				Description: "EventDataContentType specifies the type of payload in MIME format that is expected from the CloudEvent data field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation time of the trigger.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last-modified time of the trigger.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "This checksum is computed by the server based on the value of other fields, and might be sent only on create requests to ensure that the client has an up-to-date value before proceeding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Whether or not this trigger satisfies the requirements of physical zone separation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "event_filters",
+				Description: "Unordered list of filters for routing events. Only events that match all the filters will be sent to the destination.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "destination",
+				Description: "Destination specifies where the events should be sent to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "transport",
+				Description: "Represents the transport intermediaries created for the trigger to deliver events.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "conditions",
+				Description: "The reason(s) why a trigger is in FAILED state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "User labels attached to the trigger that can be used to group resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "eventarc.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listEventarcTriggers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := EventarcService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_trigger.listEventarcTriggers", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Triggers.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *eventarc.ListTriggersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, trigger := range page.Triggers {
+			d.StreamListItem(ctx, trigger)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_trigger.listEventarcTriggers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEventarcTrigger(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := EventarcService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_trigger.getEventarcTrigger", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Triggers.Get("projects/" + project + "/locations/" + location + "/triggers/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_eventarc_trigger.getEventarcTrigger", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func eventarcTriggerEventType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	filters, ok := d.Value.([]*eventarc.EventFilter)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, filter := range filters {
+		if filter.Attribute == "type" {
+			return filter.Value, nil
+		}
+	}
+	return nil, nil
+}
+
+func eventarcTriggerDestinationType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	destination, ok := d.Value.(*eventarc.Destination)
+	if !ok || destination == nil {
+		return nil, nil
+	}
+
+	switch {
+	case destination.CloudRun != nil:
+		return "CLOUD_RUN", nil
+	case destination.CloudFunction != "":
+		return "CLOUD_FUNCTION", nil
+	case destination.Gke != nil:
+		return "GKE", nil
+	case destination.Workflow != "":
+		return "WORKFLOW", nil
+	case destination.HttpEndpoint != nil:
+		return "HTTP_ENDPOINT", nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,238 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/file/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpFilestoreBackup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_filestore_backup",
+		Description: "GCP Filestore Backup",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getFilestoreBackup,
+			Tags:       map[string]string{"service": "file", "action": "backups.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFilestoreBackups,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "file", "action": "backups.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the backup.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The backup state (CREATING, FINALIZING, READY, DELETING or INVALID).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_instance",
+				Description: "The resource name of the source Filestore instance of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_instance_tier",
+				Description: "The service tier of the source Filestore instance that this backup is created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_file_share",
+				Description: "Name of the file share in the source Filestore instance that the backup is created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_system_protocol",
+				Description: "The file system protocol of the source Filestore instance that this backup is created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capacity_gb",
+				Description: "Capacity of the source file share when the backup was created.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "storage_bytes",
+				Description: "The size of the storage used by the backup.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "download_bytes",
+				Description: "Amount of bytes that will be downloaded if the backup is restored.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "kms_key",
+				Description: "The KMS key name used for data encryption.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the backup was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Whether the backup satisfies the zone isolation requirements.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Whether the backup satisfies the zone separation requirements.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "labels",
+				Description: "Resource labels to represent user provided metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(filestoreResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(filestoreResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(filestoreResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listFilestoreBackups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := FilestoreService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_backup.listFilestoreBackups", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all locations at once
+		// https://cloud.google.com/filestore/docs/reference/rest/v1/projects.locations.backups/list
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Backups.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *file.ListBackupsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, backup := range page.Backups {
+			d.StreamListItem(ctx, backup)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_backup.listFilestoreBackups", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFilestoreBackup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := FilestoreService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_backup.getFilestoreBackup", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Backups.Get("projects/" + project + "/locations/" + location + "/backups/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_backup.getFilestoreBackup", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,330 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/file/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpFilestoreInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_filestore_instance",
+		Description: "GCP Filestore Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getFilestoreInstance,
+			Tags:       map[string]string{"service": "file", "action": "instances.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFilestoreInstances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "file", "action": "instances.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The instance state (CREATING, READY, REPAIRING, DELETING, ERROR, RESTORING, SUSPENDED, SUSPENDING, RESUMING, REVERTING or PROMOTING).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the instance state, if available.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tier",
+				Description: "The service tier of the instance (BASIC_HDD, BASIC_SSD, ZONAL, REGIONAL or ENTERPRISE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol used by the instance's file shares (NFS_V3 or NFS_V4_1).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_share_name",
+				Description: "The name of the file share of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FileShares").Transform(filestoreInstanceFileShareName),
+			},
+			{
+				Name:        "capacity_gb",
+				Description: "The capacity of the file share of the instance in gigabytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("FileShares").Transform(filestoreInstanceCapacityGb),
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The KMS key name used for data encryption.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deletion_protection_enabled",
+				Description: "Indicates whether the instance is protected against deletion.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "deletion_protection_reason",
+				Description: "The reason for enabling deletion protection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "Server-specified ETag for the instance resource to prevent simultaneous updates from overwriting each other.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Whether the instance satisfies the zone isolation requirements.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Whether the instance satisfies the zone separation requirements.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "file_shares",
+				Description: "File system shares on the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "nfs_export_options",
+				Description: "The NFS export options of the file share of the instance, which control the IP ranges allowed to mount it and their access mode.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FileShares").Transform(filestoreInstanceNfsExportOptions),
+			},
+			{
+				Name:        "networks",
+				Description: "VPC networks to which the instance is connected.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "performance_config",
+				Description: "The performance configuration of the instance, when configurable performance is enabled.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "replication",
+				Description: "The replication configuration of the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "suspension_reasons",
+				Description: "The reasons for the instance being in a suspended state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Resource labels to represent user provided metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(filestoreResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(filestoreResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(filestoreResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listFilestoreInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := FilestoreService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_instance.listFilestoreInstances", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all locations at once
+		// https://cloud.google.com/filestore/docs/reference/rest/v1/projects.locations.instances/list
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Instances.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *file.ListInstancesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, instance := range page.Instances {
+			d.StreamListItem(ctx, instance)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_instance.listFilestoreInstances", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFilestoreInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := FilestoreService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_instance.getFilestoreInstance", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Instances.Get("projects/" + project + "/locations/" + location + "/instances/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_filestore_instance.getFilestoreInstance", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Filestore instances currently support a single file share
+func filestoreInstanceFileShare(d *transform.TransformData) *file.FileShareConfig {
+	fileShares, ok := d.Value.([]*file.FileShareConfig)
+	if !ok || len(fileShares) == 0 {
+		return nil
+	}
+	return fileShares[0]
+}
+
+func filestoreInstanceFileShareName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	fileShare := filestoreInstanceFileShare(d)
+	if fileShare == nil {
+		return nil, nil
+	}
+	return fileShare.Name, nil
+}
+
+func filestoreInstanceCapacityGb(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	fileShare := filestoreInstanceFileShare(d)
+	if fileShare == nil {
+		return nil, nil
+	}
+	return fileShare.CapacityGb, nil
+}
+
+func filestoreInstanceNfsExportOptions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	fileShare := filestoreInstanceFileShare(d)
+	if fileShare == nil {
+		return nil, nil
+	}
+	return fileShare.NfsExportOptions, nil
+}
+
+// Filestore resource names have the form projects/{project}/locations/{location}/...
+func filestoreResourceNamePart(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	position := d.Param.(int)
+	if len(parts) <= position {
+		return nil, nil
+	}
+	return parts[position], nil
+}
+
+func filestoreResourceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://file.googleapis.com/" + name}, nil
+}
@@ -0,0 +1,223 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/firestore/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpFirestoreIndex(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_firestore_index",
+		Description: "GCP Firestore Index",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"database_name", "collection_group", "name"}),
+			Hydrate:    getFirestoreIndex,
+			Tags:       map[string]string{"service": "firestore", "action": "indexes.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listFirestoreDatabases,
+			Hydrate:       listFirestoreIndexes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "database_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "collection_group",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "firestore", "action": "indexes.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The server defined ID of the index.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "database_name",
+				Description: "The ID of the database the index belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(firestoreIndexNamePart, 3),
+			},
+			{
+				Name:        "collection_group",
+				Description: "The collection group the index applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(firestoreIndexNamePart, 5),
+			},
+			{
+				Name:        "state",
+				Description: "The serving state of the index (CREATING, READY or NEEDS_REPAIR).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query_scope",
+				Description: "The scope of the queries supported by the index (COLLECTION, COLLECTION_GROUP or COLLECTION_RECURSIVE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_scope",
+				Description: "The API scope supported by this index (ANY_API or DATASTORE_MODE_API).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fields",
+				Description: "The fields supported by this index, along with their order or array configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(firestoreIndexAkas),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(firestoreIndexNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listFirestoreIndexes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	database := h.Item.(*firestore.GoogleFirestoreAdminV1Database)
+
+	// Minimize API call as per given database
+	databaseName := d.EqualsQualString("database_name")
+	if databaseName != "" && databaseName != getLastPathElement(database.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := FirestoreDatabaseService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_firestore_index.listFirestoreIndexes", "service_error", err)
+		return nil, err
+	}
+
+	// The wildcard "-" lists the indexes of all collection groups of the database
+	collectionGroup := "-"
+	if d.EqualsQualString("collection_group") != "" {
+		collectionGroup = d.EqualsQualString("collection_group")
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Databases.CollectionGroups.Indexes.List(database.Name + "/collectionGroups/" + collectionGroup).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *firestore.GoogleFirestoreAdminV1ListIndexesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, index := range page.Indexes {
+			d.StreamListItem(ctx, index)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_firestore_index.listFirestoreIndexes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFirestoreIndex(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	databaseName := d.EqualsQualString("database_name")
+	collectionGroup := d.EqualsQualString("collection_group")
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if databaseName == "" || collectionGroup == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := FirestoreDatabaseService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_firestore_index.getFirestoreIndex", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Databases.CollectionGroups.Indexes.Get("projects/" + project + "/databases/" + databaseName + "/collectionGroups/" + collectionGroup + "/indexes/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_firestore_index.getFirestoreIndex", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Index names have the form projects/{project}/databases/{database}/collectionGroups/{collection}/indexes/{index}
+func firestoreIndexNamePart(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	position := d.Param.(int)
+	if len(parts) <= position {
+		return nil, nil
+	}
+	return parts[position], nil
+}
+
+func firestoreIndexAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://firestore.googleapis.com/" + name}, nil
+}
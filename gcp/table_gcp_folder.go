@@ -0,0 +1,218 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpFolder(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_folder",
+		Description: "GCP Folder",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("folder_id"),
+			Hydrate:    getGCPFolder,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "folders.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGCPFolders,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "resourcemanager", "action": "folders.search"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getGCPFolderIamPolicy,
+				Tags: map[string]string{"service": "resourcemanager", "action": "folders.getIamPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the folder, in the form folders/{folder_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "folder_id",
+				Description: "The unique, system generated ID of the folder.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The folder's display name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent",
+				Description: "The folder's parent's resource name, in the form folders/{folder_id} or organizations/{organization_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The lifecycle state of the folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Timestamp when the folder was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Timestamp when the folder was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "delete_time",
+				Description: "Timestamp when the folder was requested to be deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeleteTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "A checksum computed by the server based on the current value of the folder resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the folder.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGCPFolderIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudresourcemanager.googleapis.com"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGCPFolders(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_folder.listGCPFolders", "service_error", err)
+		return nil, err
+	}
+
+	// Search all the folders the caller has access to, optionally filtered by parent and state
+	// https://cloud.google.com/resource-manager/reference/rest/v3/folders/search
+	query := ""
+	if parent := d.EqualsQualString("parent"); parent != "" {
+		query = "parent=" + parent
+	}
+	if state := d.EqualsQualString("state"); state != "" {
+		if query != "" {
+			query += " AND "
+		}
+		query += "state=" + state
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Folders.Search().Query(query).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudresourcemanager3.SearchFoldersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, folder := range page.Folders {
+			d.StreamListItem(ctx, folder)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_folder.listGCPFolders", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGCPFolder(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	folderId := d.EqualsQualString("folder_id")
+
+	// Empty check
+	if folderId == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_folder.getGCPFolder", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Folders.Get("folders/" + folderId).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_folder.getGCPFolder", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getGCPFolderIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	folder := h.Item.(*cloudresourcemanager3.Folder)
+
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_folder.getGCPFolderIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	rb := &cloudresourcemanager3.GetIamPolicyRequest{
+		Options: &cloudresourcemanager3.GetPolicyOptions{
+			RequestedPolicyVersion: 3,
+		},
+	}
+	resp, err := service.Folders.GetIamPolicy(folder.Name, rb).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_folder.getGCPFolderIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,232 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/iam/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIamCustomRole(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_iam_custom_role",
+		Description: "GCP IAM Custom Role",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getIamCustomRole,
+			Tags:       map[string]string{"service": "iam", "action": "roles.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIamCustomRoles,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "iam", "action": "roles.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the role, in the form projects/{project_id}/roles/{role_id} or organizations/{organization_id}/roles/{role_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_id",
+				Description: "The ID of the role.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the custom role is defined in, either project or organization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpIamCustomRoleParent, "Scope"),
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization the custom role is defined in. Only set for organization-level custom roles.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpIamCustomRoleParent, "OrganizationId"),
+			},
+			{
+				Name:        "deleted",
+				Description: "Specifies whether the role is deleted, or not.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "stage",
+				Description: "The current launch stage of the role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A human-readable description for the role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "included_permissions",
+				Description: "The names of the permissions this role grants when bound in an IAM policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Title", "Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(gcpIamCustomRoleAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIamCustomRoles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := IAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_custom_role.listIamCustomRoles", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.48.0/iam/v1#ProjectsRolesListCall.PageSize
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	streamRoles := func(page *iam.ListRolesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, role := range page.Roles {
+			d.StreamListItem(ctx, role)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// List the custom roles of the organization if the organization ID is given,
+	// else the custom roles of the project
+	if organizationId := d.EqualsQualString("organization_id"); organizationId != "" {
+		resp := service.Organizations.Roles.List("organizations/" + organizationId).View("FULL").ShowDeleted(true).PageSize(*pageSize)
+		if err := resp.Pages(ctx, streamRoles); err != nil {
+			plugin.Logger(ctx).Error("gcp_iam_custom_role.listIamCustomRoles", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Roles.List("projects/" + project).View("FULL").ShowDeleted(true).PageSize(*pageSize)
+	if err := resp.Pages(ctx, streamRoles); err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_custom_role.listIamCustomRoles", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIamCustomRole(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Only custom roles can be fetched from this table
+	if !strings.HasPrefix(name, "projects/") && !strings.HasPrefix(name, "organizations/") {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := IAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_custom_role.getIamCustomRole", "service_error", err)
+		return nil, err
+	}
+
+	var role *iam.Role
+	if strings.HasPrefix(name, "organizations/") {
+		role, err = service.Organizations.Roles.Get(name).Do()
+	} else {
+		role, err = service.Projects.Roles.Get(name).Do()
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_custom_role.getIamCustomRole", "api_error", err)
+		return nil, err
+	}
+
+	return role, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpIamCustomRoleParent(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	role := d.HydrateItem.(*iam.Role)
+	parts := strings.Split(role.Name, "/")
+
+	switch d.Param.(string) {
+	case "Scope":
+		if parts[0] == "organizations" {
+			return "organization", nil
+		}
+		return "project", nil
+	case "OrganizationId":
+		if parts[0] == "organizations" {
+			return parts[1], nil
+		}
+	}
+	return nil, nil
+}
+
+func gcpIamCustomRoleAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return []string{"gcp://iam.googleapis.com/" + d.Value.(string)}, nil
+}
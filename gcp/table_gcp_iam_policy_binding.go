@@ -0,0 +1,195 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIAMPolicyBinding(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_iam_policy_binding",
+		Description: "GCP IAM Policy Binding",
+		List: &plugin.ListConfig{
+			Hydrate: listGcpIamPolicyBindings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "role", Require: plugin.Optional},
+				{Name: "member", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "resourcemanager", "action": "projects.getIamPolicy"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "role",
+				Description: "The role that is assigned to the member, for example roles/viewer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member",
+				Description: "The principal the role is granted to, for example user:alice@example.com or serviceAccount:my-sa@my-project.iam.gserviceaccount.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of the principal, for example user, serviceAccount, group, domain, allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member").Transform(iamPolicyBindingMemberType),
+			},
+			{
+				Name:        "member_id",
+				Description: "The identifier of the principal without its type prefix, for example alice@example.com.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member").Transform(iamPolicyBindingMemberId),
+			},
+			{
+				Name:        "condition_title",
+				Description: "Title of the condition of the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.Title"),
+			},
+			{
+				Name:        "condition_description",
+				Description: "Description of the condition of the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.Description"),
+			},
+			{
+				Name:        "condition_expression",
+				Description: "The Common Expression Language (CEL) expression of the condition of the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.Expression"),
+			},
+			{
+				Name:        "etag",
+				Description: "Etag of the IAM policy the binding belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "Version of the IAM policy the binding belongs to.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(iamPolicyBindingTitle),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type iamPolicyBindingRow struct {
+	Role      string
+	Member    string
+	Condition *cloudresourcemanager.Expr
+	Etag      string
+	Version   int64
+}
+
+//// FETCH FUNCTIONS
+
+func listGcpIamPolicyBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_policy_binding.listGcpIamPolicyBindings", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Request the version 3 of the policy to get the conditions of the bindings
+	rb := &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{
+			RequestedPolicyVersion: 3,
+		},
+	}
+	resp, err := service.Projects.GetIamPolicy(project, rb).Context(ctx).Do()
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_policy_binding.listGcpIamPolicyBindings", "api_error", err)
+		return nil, err
+	}
+
+	role := d.EqualsQualString("role")
+	member := d.EqualsQualString("member")
+
+	for _, binding := range resp.Bindings {
+		if role != "" && role != binding.Role {
+			continue
+		}
+		for _, m := range binding.Members {
+			if member != "" && member != m {
+				continue
+			}
+			d.StreamListItem(ctx, &iamPolicyBindingRow{
+				Role:      binding.Role,
+				Member:    m,
+				Condition: binding.Condition,
+				Etag:      resp.Etag,
+				Version:   resp.Version,
+			})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Members have the form {type}:{id}, except allUsers and allAuthenticatedUsers
+func iamPolicyBindingMemberType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	memberType, _, _ := strings.Cut(d.Value.(string), ":")
+	return memberType, nil
+}
+
+func iamPolicyBindingMemberId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	_, memberId, found := strings.Cut(d.Value.(string), ":")
+	if !found {
+		return nil, nil
+	}
+	return memberId, nil
+}
+
+func iamPolicyBindingTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	binding := d.HydrateItem.(*iamPolicyBindingRow)
+	return binding.Role + " " + binding.Member, nil
+}
@@ -0,0 +1,183 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/iam/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIamWorkloadIdentityPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_iam_workload_identity_pool",
+		Description: "GCP IAM Workload Identity Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getIamWorkloadIdentityPool,
+			Tags:       map[string]string{"service": "iam", "action": "workloadIdentityPools.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIamWorkloadIdentityPools,
+			Tags:    map[string]string{"service": "iam", "action": "workloadIdentityPools.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "A display name for the pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disabled",
+				Description: "Whether the pool is disabled. You cannot use a disabled pool to exchange tokens, or use existing tokens to access resources.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "expire_time",
+				Description: "Time after which the workload identity pool will be permanently purged and cannot be recovered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName", "Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "iam.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIamWorkloadIdentityPools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := IAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool.listIamWorkloadIdentityPools", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Workload identity pools are global resources
+	resp := service.Projects.Locations.WorkloadIdentityPools.List("projects/" + project + "/locations/global").ShowDeleted(true).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *iam.ListWorkloadIdentityPoolsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, pool := range page.WorkloadIdentityPools {
+			d.StreamListItem(ctx, pool)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool.listIamWorkloadIdentityPools", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIamWorkloadIdentityPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := IAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool.getIamWorkloadIdentityPool", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.WorkloadIdentityPools.Get("projects/" + project + "/locations/global/workloadIdentityPools/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool.getIamWorkloadIdentityPool", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,259 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/iam/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIamWorkloadIdentityPoolProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_iam_workload_identity_pool_provider",
+		Description: "GCP IAM Workload Identity Pool Provider",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"pool_name", "name"}),
+			Hydrate:    getIamWorkloadIdentityPoolProvider,
+			Tags:       map[string]string{"service": "iam", "action": "workloadIdentityPoolProviders.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listIamWorkloadIdentityPools,
+			Hydrate:       listIamWorkloadIdentityPoolProviders,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "pool_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "iam", "action": "workloadIdentityPoolProviders.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "pool_name",
+				Description: "The ID of the workload identity pool the provider belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "display_name",
+				Description: "A display name for the provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description for the provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disabled",
+				Description: "Whether the provider is disabled. You cannot use a disabled provider to exchange tokens.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "provider_type",
+				Description: "The type of the identity provider, one of oidc, aws or saml.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(iamWorkloadIdentityPoolProviderType),
+			},
+			{
+				Name:        "issuer_uri",
+				Description: "The OIDC issuer URL. Only set for OIDC providers.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Oidc.IssuerUri"),
+			},
+			{
+				Name:        "aws_account_id",
+				Description: "The AWS account ID. Only set for AWS providers.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Aws.AccountId"),
+			},
+			{
+				Name:        "attribute_condition",
+				Description: "A Common Expression Language expression that credentials must satisfy to be accepted by the provider. If empty, all valid credentials are accepted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "expire_time",
+				Description: "Time after which the workload identity pool provider will be permanently purged and cannot be recovered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "allowed_audiences",
+				Description: "Acceptable values for the aud field (audience) in the OIDC token. Only set for OIDC providers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Oidc.AllowedAudiences"),
+			},
+			{
+				Name:        "attribute_mapping",
+				Description: "Maps attributes from authentication credentials issued by an external identity provider to Google Cloud attributes, such as subject and segment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "oidc",
+				Description: "An OpenId Connect 1.0 identity provider.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "aws",
+				Description: "An Amazon Web Services identity provider.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "saml",
+				Description: "An SAML 2.0 identity provider.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName", "Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "iam.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIamWorkloadIdentityPoolProviders(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pool := h.Item.(*iam.WorkloadIdentityPool)
+
+	// Minimize the API call with the given pool name
+	poolName := d.EqualsQualString("pool_name")
+	if poolName != "" && poolName != getLastPathElement(pool.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := IAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool_provider.listIamWorkloadIdentityPoolProviders", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Locations.WorkloadIdentityPools.Providers.List(pool.Name).ShowDeleted(true).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *iam.ListWorkloadIdentityPoolProvidersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, provider := range page.WorkloadIdentityPoolProviders {
+			d.StreamListItem(ctx, provider)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool_provider.listIamWorkloadIdentityPoolProviders", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIamWorkloadIdentityPoolProvider(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	poolName := d.EqualsQualString("pool_name")
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if poolName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := IAMService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool_provider.getIamWorkloadIdentityPoolProvider", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.WorkloadIdentityPools.Providers.Get("projects/" + project + "/locations/global/workloadIdentityPools/" + poolName + "/providers/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iam_workload_identity_pool_provider.getIamWorkloadIdentityPoolProvider", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func iamWorkloadIdentityPoolProviderType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	provider := d.HydrateItem.(*iam.WorkloadIdentityPoolProvider)
+
+	switch {
+	case provider.Oidc != nil:
+		return "oidc", nil
+	case provider.Aws != nil:
+		return "aws", nil
+	case provider.Saml != nil:
+		return "saml", nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,390 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/appengine/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/iap/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIapSettings(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_iap_settings",
+		Description: "GCP Identity-Aware Proxy Settings",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getIapSettings,
+			Tags:       map[string]string{"service": "iap", "action": "v1.getIapSettings"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIapSettings,
+			Tags:    map[string]string{"service": "iap", "action": "v1.getIapSettings"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the IAP protected resource the settings apply to, for example projects/{project_number}/iap_web/compute/services/{backend_service}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource the settings apply to (PROJECT, COMPUTE, BACKEND_SERVICE, APP_ENGINE or APP_ENGINE_SERVICE). Settings are inherited from the project to the resources below it.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(iapSettingsResourceType),
+			},
+			{
+				Name:        "allowed_domains_enabled",
+				Description: "Specifies whether the access to the resource is restricted to the allowed domains.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AccessSettings.AllowedDomainsSettings.Enable"),
+			},
+			{
+				Name:        "cors_allow_http_options",
+				Description: "Specifies whether HTTP OPTIONS calls are allowed to bypass IAP authentication and authorization.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AccessSettings.CorsSettings.AllowHttpOptions"),
+			},
+			{
+				Name:        "gcip_login_page_uri",
+				Description: "The login page URI used when the users are authenticated with Identity Platform.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessSettings.GcipSettings.LoginPageUri"),
+			},
+			{
+				Name:        "oauth_login_hint",
+				Description: "The domain hint sent to the OAuth login page, to select the account of the users.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessSettings.OauthSettings.LoginHint"),
+			},
+			{
+				Name:        "reauth_method",
+				Description: "The reauthentication method required when the users access the resource (LOGIN, SECURE_KEY or ENROLLED_SECOND_FACTORS).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessSettings.ReauthSettings.Method").NullIfZero(),
+			},
+			{
+				Name:        "reauth_max_age",
+				Description: "The maximum age of the last authentication before the users must reauthenticate, for example 3600s.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessSettings.ReauthSettings.MaxAge"),
+			},
+			{
+				Name:        "reauth_policy_type",
+				Description: "How the reauthentication settings are combined with the ones of the parent resources (MINIMUM or DEFAULT).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessSettings.ReauthSettings.PolicyType"),
+			},
+			{
+				Name:        "cookie_domain",
+				Description: "The domain of the IAP session cookie.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationSettings.CookieDomain"),
+			},
+			{
+				Name:        "access_denied_page_uri",
+				Description: "The URI the users are redirected to when they are denied access to the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationSettings.AccessDeniedPageSettings.AccessDeniedPageUri"),
+			},
+			{
+				Name:        "attribute_propagation_enabled",
+				Description: "Specifies whether the attributes of the users are propagated to the protected application.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ApplicationSettings.AttributePropagationSettings.Enable"),
+			},
+			{
+				Name:        "allowed_domains",
+				Description: "The domains the users must belong to, to access the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessSettings.AllowedDomainsSettings.Domains"),
+			},
+			{
+				Name:        "identity_sources",
+				Description: "The identity sources used to authenticate the users, when they are not Google identities.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessSettings.IdentitySources"),
+			},
+			{
+				Name:        "gcip_tenant_ids",
+				Description: "The Identity Platform tenants used to authenticate the users.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessSettings.GcipSettings.TenantIds"),
+			},
+			{
+				Name:        "programmatic_clients",
+				Description: "The OAuth client IDs allowed to access the resource programmatically.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessSettings.OauthSettings.ProgrammaticClients"),
+			},
+			{
+				Name:        "access_settings",
+				Description: "The settings configuring the access to the resource, such as the allowed domains, the identity sources and the reauthentication.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "application_settings",
+				Description: "The settings configuring the behaviour of the protected application, such as the cookie domain and the access denied page.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "iap.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// The settings are listed for the project, for the Compute Engine resources,
+// and for the backend services and the App Engine application protected by IAP
+func listIapSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := IAPService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iap_settings.listIapSettings", "service_error", err)
+		return nil, err
+	}
+
+	projectNumber, err := getIapProjectNumber(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iap_settings.listIapSettings", "project_error", err)
+		return nil, err
+	}
+	parent := "projects/" + projectNumber.(string) + "/iap_web"
+
+	resources := []string{parent, parent + "/compute"}
+
+	protectedResources, err := listIapProtectedResources(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iap_settings.listIapSettings", "api_error", err)
+		return nil, err
+	}
+	resources = append(resources, protectedResources...)
+
+	for _, resource := range resources {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		resp, err := service.V1.GetIapSettings(resource).Do()
+		if err != nil {
+			if isIgnorableError([]string{"404"})(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("gcp_iap_settings.listIapSettings", "api_error", err)
+			return nil, err
+		}
+		d.StreamListItem(ctx, resp)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIapSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := IAPService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iap_settings.getIapSettings", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.V1.GetIapSettings(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_iap_settings.getIapSettings", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// IAP resource names use the project number, e.g. projects/{project_number}/iap_web
+var getIapProjectNumberMemoized = plugin.HydrateFunc(getIapProjectNumberUncached).Memoize(memoize.WithCacheKeyFunction(getIapProjectNumberCacheKey))
+
+func getIapProjectNumberCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheKey := "getIapProjectNumber"
+	return cacheKey, nil
+}
+
+func getIapProjectNumber(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getIapProjectNumberMemoized(ctx, d, h)
+}
+
+func getIapProjectNumberUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Get(project).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprint(resp.ProjectNumber), nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func iapSettingsResourceType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+
+	// projects/{project_number}/iap_web[/{resource}[/services/{service}]]
+	parts := strings.Split(name, "/")
+	if len(parts) < 4 {
+		return "PROJECT", nil
+	}
+
+	switch {
+	case strings.HasPrefix(parts[3], "appengine-") && len(parts) > 5:
+		return "APP_ENGINE_SERVICE", nil
+	case strings.HasPrefix(parts[3], "appengine-"):
+		return "APP_ENGINE", nil
+	case strings.HasPrefix(parts[3], "compute") && len(parts) > 5:
+		return "BACKEND_SERVICE", nil
+	case strings.HasPrefix(parts[3], "compute"):
+		return "COMPUTE", nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// listIapProtectedResources returns the IAP resource names of the backend
+// services and the App Engine application which have IAP enabled
+func listIapProtectedResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) ([]string, error) {
+	var resources []string
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	projectNumber, err := getIapProjectNumber(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := service.BackendServices.AggregatedList(project).Filter("iap.enabled=true")
+	if err := resp.Pages(ctx, func(page *compute.BackendServiceAggregatedList) error {
+		for _, item := range page.Items {
+			for _, backendService := range item.BackendServices {
+				resources = append(resources, iapBackendServiceResourceName(projectNumber.(string), backendService))
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	application, err := getAppEngineApplicationMemoized(ctx, d, h)
+	if err != nil {
+		// The project does not have an App Engine application
+		if isIgnorableError([]string{"404"})(err) {
+			return resources, nil
+		}
+		return nil, err
+	}
+	if app := application.(*appengine.Application); app.Iap != nil && app.Iap.Enabled {
+		resources = append(resources, iapAppEngineResourceName(projectNumber.(string), app))
+	}
+
+	return resources, nil
+}
+
+// Global backend services are under iap_web/compute, regional ones under iap_web/compute-{region}
+func iapBackendServiceResourceName(projectNumber string, backendService *compute.BackendService) string {
+	resourceType := "compute"
+	if region := getLastPathElement(types.SafeString(backendService.Region)); region != "" {
+		resourceType = "compute-" + region
+	}
+	return "projects/" + projectNumber + "/iap_web/" + resourceType + "/services/" + backendService.Name
+}
+
+func iapAppEngineResourceName(projectNumber string, application *appengine.Application) string {
+	return "projects/" + projectNumber + "/iap_web/appengine-" + application.Id
+}
+
+func getIapIamPolicy(ctx context.Context, d *plugin.QueryData, resource string) (*iap.Policy, error) {
+	// Create Service Connection
+	service, err := IAPService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.V1.GetIamPolicy(resource, &iap.GetIamPolicyRequest{}).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
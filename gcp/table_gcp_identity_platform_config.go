@@ -0,0 +1,293 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/identitytoolkit/v2"
+)
+
+//// TABLE DEFINITION
+
+// A project has a single Identity Platform (or Firebase Authentication) configuration
+func tableGcpIdentityPlatformConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_identity_platform_config",
+		Description: "GCP Identity Platform Config",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityPlatformConfigs,
+			Tags:    map[string]string{"service": "identitytoolkit", "action": "projects.getConfig"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getIdentityPlatformConfigSignInProviders,
+				Tags: map[string]string{"service": "identitytoolkit", "action": "defaultSupportedIdpConfigs.list"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration resource, in the form projects/{project}/config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subtype",
+				Description: "The subtype of the configuration, IDENTITY_PLATFORM for an Identity Platform project, or FIREBASE_AUTH for a Firebase Authentication project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_sign_in_enabled",
+				Description: "Specifies whether the users can sign in with an email address.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SignIn.Email.Enabled"),
+			},
+			{
+				Name:        "email_password_required",
+				Description: "Specifies whether a password is required to sign in with an email address. If false, the users sign in with an email link.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SignIn.Email.PasswordRequired"),
+			},
+			{
+				Name:        "phone_sign_in_enabled",
+				Description: "Specifies whether the users can sign in with a phone number.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SignIn.PhoneNumber.Enabled"),
+			},
+			{
+				Name:        "anonymous_sign_in_enabled",
+				Description: "Specifies whether the users can sign in anonymously.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SignIn.Anonymous.Enabled"),
+			},
+			{
+				Name:        "allow_duplicate_emails",
+				Description: "Specifies whether several accounts can have the same email address.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SignIn.AllowDuplicateEmails"),
+			},
+			{
+				Name:        "autodelete_anonymous_users",
+				Description: "Specifies whether the anonymous users are automatically deleted after 30 days.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "mfa_state",
+				Description: "The state of the multi-factor authentication (DISABLED, ENABLED or MANDATORY).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Mfa.State"),
+			},
+			{
+				Name:        "allow_tenants",
+				Description: "Specifies whether tenants can be created in the project.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MultiTenant.AllowTenants"),
+			},
+			{
+				Name:        "improved_email_privacy_enabled",
+				Description: "Specifies whether the email enumeration protection is enabled, so that the API does not reveal whether an email address is registered.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("EmailPrivacyConfig.EnableImprovedEmailPrivacy"),
+			},
+			{
+				Name:        "password_policy_enforcement_state",
+				Description: "The enforcement state of the password policy (OFF or ENFORCE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PasswordPolicyConfig.PasswordPolicyEnforcementState"),
+			},
+			{
+				Name:        "authorized_domains",
+				Description: "The domains authorized for OAuth redirects.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "mfa_enabled_providers",
+				Description: "The second factors enabled for the multi-factor authentication, for example PHONE_SMS.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Mfa.EnabledProviders"),
+			},
+			{
+				Name:        "mfa",
+				Description: "The multi-factor authentication configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "blocking_functions",
+				Description: "The blocking functions triggered before the users are created or signed in, along with their function URI.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sign_in_providers",
+				Description: "The identity providers the users can sign in with, such as google.com, OIDC and SAML providers, along with their type and whether they are enabled.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIdentityPlatformConfigSignInProviders,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "password_policy_config",
+				Description: "The password policy of the project, such as the password constraints and the enforcement state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sms_region_config",
+				Description: "The regions to which SMS verification codes can be sent.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "quota",
+				Description: "The sign-up quota of the project.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "identitytoolkit.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIdentityPlatformConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := IdentityPlatformService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_identity_platform_config.listIdentityPlatformConfigs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.GetConfig("projects/" + project + "/config").Do()
+	if err != nil {
+		// Identity Platform is not set up in the project
+		if isIgnorableError([]string{"404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("gcp_identity_platform_config.listIdentityPlatformConfigs", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, resp)
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIdentityPlatformConfigSignInProviders(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	providers, err := listIdentityPlatformSignInProviders(ctx, d, "projects/"+project)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_identity_platform_config.getIdentityPlatformConfigSignInProviders", "api_error", err)
+		return nil, err
+	}
+
+	return providers, nil
+}
+
+//// UTILITY FUNCTIONS
+
+type identityPlatformSignInProvider struct {
+	ProviderId  string `json:"providerId"`
+	Type        string `json:"type"`
+	DisplayName string `json:"displayName,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// listIdentityPlatformSignInProviders returns the identity providers of a
+// project, or of a tenant when the parent is projects/{project}/tenants/{tenant}.
+// The client IDs and secrets of the providers are deliberately left out.
+func listIdentityPlatformSignInProviders(ctx context.Context, d *plugin.QueryData, parent string) ([]identityPlatformSignInProvider, error) {
+	var providers []identityPlatformSignInProvider
+
+	// Create Service Connection
+	service, err := IdentityPlatformService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	addDefaultSupportedIdps := func(page *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2ListDefaultSupportedIdpConfigsResponse) error {
+		for _, idp := range page.DefaultSupportedIdpConfigs {
+			providers = append(providers, identityPlatformSignInProvider{ProviderId: getLastPathElement(idp.Name), Type: "DEFAULT", Enabled: idp.Enabled})
+		}
+		return nil
+	}
+	addOAuthIdps := func(page *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2ListOAuthIdpConfigsResponse) error {
+		for _, idp := range page.OauthIdpConfigs {
+			providers = append(providers, identityPlatformSignInProvider{ProviderId: getLastPathElement(idp.Name), Type: "OIDC", DisplayName: idp.DisplayName, Enabled: idp.Enabled})
+		}
+		return nil
+	}
+	addSamlIdps := func(page *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2ListInboundSamlConfigsResponse) error {
+		for _, idp := range page.InboundSamlConfigs {
+			providers = append(providers, identityPlatformSignInProvider{ProviderId: getLastPathElement(idp.Name), Type: "SAML", DisplayName: idp.DisplayName, Enabled: idp.Enabled})
+		}
+		return nil
+	}
+
+	// The tenants have their own identity providers
+	if strings.Contains(parent, "/tenants/") {
+		if err := service.Projects.Tenants.DefaultSupportedIdpConfigs.List(parent).Pages(ctx, addDefaultSupportedIdps); err != nil {
+			return nil, err
+		}
+		if err := service.Projects.Tenants.OauthIdpConfigs.List(parent).Pages(ctx, addOAuthIdps); err != nil {
+			return nil, err
+		}
+		if err := service.Projects.Tenants.InboundSamlConfigs.List(parent).Pages(ctx, addSamlIdps); err != nil {
+			return nil, err
+		}
+		return providers, nil
+	}
+
+	if err := service.Projects.DefaultSupportedIdpConfigs.List(parent).Pages(ctx, addDefaultSupportedIdps); err != nil {
+		return nil, err
+	}
+	if err := service.Projects.OauthIdpConfigs.List(parent).Pages(ctx, addOAuthIdps); err != nil {
+		return nil, err
+	}
+	if err := service.Projects.InboundSamlConfigs.List(parent).Pages(ctx, addSamlIdps); err != nil {
+		return nil, err
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,271 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/identitytoolkit/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIdentityPlatformTenant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_identity_platform_tenant",
+		Description: "GCP Identity Platform Tenant",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getIdentityPlatformTenant,
+			Tags:       map[string]string{"service": "identitytoolkit", "action": "tenants.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityPlatformTenants,
+			Tags:    map[string]string{"service": "identitytoolkit", "action": "tenants.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getIdentityPlatformTenantSignInProviders,
+				Tags: map[string]string{"service": "identitytoolkit", "action": "defaultSupportedIdpConfigs.list"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the tenant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the tenant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allow_password_signup",
+				Description: "Specifies whether the users can sign up with an email address and a password.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_email_link_signin",
+				Description: "Specifies whether the users can sign in with an email link.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_anonymous_user",
+				Description: "Specifies whether the users can sign in anonymously.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "disable_auth",
+				Description: "Specifies whether the authentication is disabled for the tenant. If true, the users cannot sign in, and the administrators cannot manage the accounts of the users.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "autodelete_anonymous_users",
+				Description: "Specifies whether the anonymous users are automatically deleted after 30 days.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "mfa_state",
+				Description: "The state of the multi-factor authentication (DISABLED, ENABLED or MANDATORY).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MfaConfig.State"),
+			},
+			{
+				Name:        "improved_email_privacy_enabled",
+				Description: "Specifies whether the email enumeration protection is enabled, so that the API does not reveal whether an email address is registered.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("EmailPrivacyConfig.EnableImprovedEmailPrivacy"),
+			},
+			{
+				Name:        "password_policy_enforcement_state",
+				Description: "The enforcement state of the password policy (OFF or ENFORCE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PasswordPolicyConfig.PasswordPolicyEnforcementState"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the tenant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "mfa_enabled_providers",
+				Description: "The second factors enabled for the multi-factor authentication, for example PHONE_SMS.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MfaConfig.EnabledProviders"),
+			},
+			{
+				Name:        "mfa_config",
+				Description: "The multi-factor authentication configuration of the tenant.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sign_in_providers",
+				Description: "The identity providers the users of the tenant can sign in with, such as google.com, OIDC and SAML providers, along with their type and whether they are enabled.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIdentityPlatformTenantSignInProviders,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "inheritance",
+				Description: "The settings the tenant inherits from the project configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "password_policy_config",
+				Description: "The password policy of the tenant, such as the password constraints and the enforcement state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sms_region_config",
+				Description: "The regions to which SMS verification codes can be sent.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(identityPlatformTenantTitle),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "identitytoolkit.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIdentityPlatformTenants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := IdentityPlatformService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_identity_platform_tenant.listIdentityPlatformTenants", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	// https://cloud.google.com/identity-platform/docs/reference/rest/v2/projects.tenants/list
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Tenants.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2ListTenantsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, tenant := range page.Tenants {
+			d.StreamListItem(ctx, tenant)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		// Identity Platform is not set up in the project
+		if isIgnorableError([]string{"404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("gcp_identity_platform_tenant.listIdentityPlatformTenants", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIdentityPlatformTenant(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := IdentityPlatformService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_identity_platform_tenant.getIdentityPlatformTenant", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Tenants.Get("projects/" + project + "/tenants/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_identity_platform_tenant.getIdentityPlatformTenant", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getIdentityPlatformTenantSignInProviders(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tenant := h.Item.(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant)
+
+	providers, err := listIdentityPlatformSignInProviders(ctx, d, tenant.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_identity_platform_tenant.getIdentityPlatformTenantSignInProviders", "api_error", err)
+		return nil, err
+	}
+
+	return providers, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func identityPlatformTenantTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tenant := d.HydrateItem.(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant)
+
+	if tenant.DisplayName != "" {
+		return tenant.DisplayName, nil
+	}
+	return getLastPathElement(tenant.Name), nil
+}
@@ -80,6 +80,34 @@ func tableGcpKmsKey(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("RotationPeriod").Transform(transform.NullIfZeroValue),
 			},
+			{
+				Name:        "protection_level",
+				Description: "The protection level to use when creating a version based on this template, one of SOFTWARE, HSM, EXTERNAL or EXTERNAL_VPC.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VersionTemplate.ProtectionLevel"),
+			},
+			{
+				Name:        "algorithm",
+				Description: "The algorithm to use when creating a version based on this template.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VersionTemplate.Algorithm"),
+			},
+			{
+				Name:        "primary_version_state",
+				Description: "The current state of the primary CryptoKeyVersion. Only set for keys with purpose ENCRYPT_DECRYPT.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Primary.State"),
+			},
+			{
+				Name:        "import_only",
+				Description: "Whether this key may contain imported versions only.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "destroy_scheduled_duration",
+				Description: "The period of time that versions of this key spend in the DESTROY_SCHEDULED state before transitioning to DESTROYED.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "iam_policy",
 				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources. A `Policy` is a collection of `bindings`. A `binding` binds one or more `members` to a single `role`. Members can be user accounts, service accounts, Google groups, and domains (such as G Suite). A `role` is a named list of permissions; each `role` can be an IAM predefined role or a user-created custom role. For some types of Google Cloud resources, a `binding` can also specify a `condition`, which is a logical expression that allows access to a resource only if the expression evaluates to `true`.",
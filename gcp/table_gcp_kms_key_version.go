@@ -27,7 +27,10 @@ func tableGcpKmsKeyVersion(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate:       listKeyVersionDetails,
 			ParentHydrate: listKeyRingDetails,
-			Tags:          map[string]string{"service": "cloudkms", "action": "cryptoKeyVersions.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "state", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+			Tags: map[string]string{"service": "cloudkms", "action": "cryptoKeyVersions.list"},
 		},
 		GetMatrixItemFunc: BuildLocationList,
 		Columns: []*plugin.Column{
@@ -203,7 +206,17 @@ func getCryptoKeyVersionDetailsAsync(ctx context.Context, d *plugin.QueryData, h
 }
 
 func getCryptoKeyVersionDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, key *cloudkms.CryptoKey, pageSize *int64, service *cloudkms.Service) error {
-	resp := service.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.List(key.Name).PageSize(*pageSize)
+	filterQuals := []filterQualMap{
+		{"state", "state", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	resp := service.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.List(key.Name).Filter(filterString).PageSize(*pageSize)
 
 	err := resp.Pages(ctx, func(page *cloudkms.ListCryptoKeyVersionsResponse) error {
 		// apply rate limiting
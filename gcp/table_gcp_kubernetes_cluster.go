@@ -81,6 +81,36 @@ func tableGcpKubernetesCluster(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_BOOL,
 				Transform:   transform.FromField("ShieldedNodes.Enabled"),
 			},
+			{
+				Name:        "release_channel_name",
+				Description: "The release channel the cluster is subscribed to. Possible values are UNSPECIFIED, RAPID, REGULAR, STABLE and EXTENDED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReleaseChannel.Channel"),
+			},
+			{
+				Name:        "private_nodes_enabled",
+				Description: "Denotes whether nodes have internal IP addresses only.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PrivateClusterConfig.EnablePrivateNodes"),
+			},
+			{
+				Name:        "private_endpoint_enabled",
+				Description: "Denotes whether the master's internal IP address is used as the cluster endpoint.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PrivateClusterConfig.EnablePrivateEndpoint"),
+			},
+			{
+				Name:        "workload_pool",
+				Description: "The workload pool to attach all Kubernetes service accounts to, when workload identity is enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkloadIdentityConfig.WorkloadPool"),
+			},
+			{
+				Name:        "network_policy_enabled",
+				Description: "Denotes whether network policy is enabled on the cluster.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("NetworkPolicy.Enabled"),
+			},
 			{
 				Name:        "database_encryption_key_name",
 				Description: "Name of CloudKMS key to use for the encryption.",
@@ -69,6 +69,48 @@ func tableGcpKubernetesNodePool(ctx context.Context) *plugin.Table {
 				Description: "The pod CIDR block size per node in this node pool.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "machine_type",
+				Description: "The name of a Google Compute Engine machine type used by the nodes of this pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.MachineType"),
+			},
+			{
+				Name:        "autoscaling_enabled",
+				Description: "Denotes whether node autoscaling is enabled for this node pool.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Autoscaling.Enabled"),
+			},
+			{
+				Name:        "autoscaling_min_node_count",
+				Description: "Minimum number of nodes for one location in the node pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Autoscaling.MinNodeCount"),
+			},
+			{
+				Name:        "autoscaling_max_node_count",
+				Description: "Maximum number of nodes for one location in the node pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Autoscaling.MaxNodeCount"),
+			},
+			{
+				Name:        "secure_boot_enabled",
+				Description: "Denotes whether the nodes of this pool are created with Secure Boot enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Config.ShieldedInstanceConfig.EnableSecureBoot"),
+			},
+			{
+				Name:        "integrity_monitoring_enabled",
+				Description: "Denotes whether the nodes of this pool are created with integrity monitoring enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Config.ShieldedInstanceConfig.EnableIntegrityMonitoring"),
+			},
+			{
+				Name:        "service_account",
+				Description: "The Google Cloud Platform service account used by the nodes of this pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.ServiceAccount"),
+			},
 			{
 				Name:        "autoscaling",
 				Description: "Autoscaler configuration for this node pool.",
@@ -66,12 +66,38 @@ func tableGcpLoggingBucket(_ context.Context) *plugin.Table {
 				Description: "Logs will be retained by default for this amount of time, after which they will automatically be deleted.",
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "analytics_enabled",
+				Description: "Specifies whether log analytics is enabled for the bucket. Once enabled, log analytics features cannot be disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The resource name of the Cloud KMS key used to encrypt the logs stored in the bucket, if customer-managed encryption keys (CMEK) are enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CmekSettings.KmsKeyName"),
+			},
 			{
 				Name:        "update_time",
 				Description: "The last update timestamp of the bucket.",
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromGo().NullIfZero(),
 			},
+			{
+				Name:        "cmek_settings",
+				Description: "The customer-managed encryption key (CMEK) settings of the bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "index_configs",
+				Description: "A list of indexed fields and related configuration data.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "restricted_fields",
+				Description: "Log entry field paths that are denied access in the bucket.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// GCP standard columns
 			{
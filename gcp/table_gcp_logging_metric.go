@@ -37,6 +37,16 @@ func tableGcpLoggingMetric(_ context.Context) *plugin.Table {
 				Description: "A user-specified, human-readable description of the metric.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "disabled",
+				Description: "Specifies whether the metric is disabled, or not.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "bucket_name",
+				Description: "The resource name of the log bucket that owns the metric. If empty, the metric matches the log entries of all the buckets of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "version",
 				Description: "The API version that created or updated this metric.",
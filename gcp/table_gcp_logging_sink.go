@@ -2,6 +2,7 @@ package gcp
 
 import (
 	"context"
+	"strings"
 
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -37,6 +38,12 @@ func tableGcpLoggingSink(_ context.Context) *plugin.Table {
 				Description: "Specifies the destination, in which the logs will be exported",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "destination_type",
+				Description: "The type of the destination, in which the logs will be exported (storage, bigquery, pubsub or logging)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination").Transform(loggingSinkDestinationType),
+			},
 			{
 				Name:        "disabled",
 				Description: "Specifies whether the sink is disabled, or not",
@@ -63,6 +70,11 @@ func tableGcpLoggingSink(_ context.Context) *plugin.Table {
 				Description: "Specifies whether a particular log entry from the children is exported depends on the sink's filter expression",
 				Type:        proto.ColumnType_BOOL,
 			},
+			{
+				Name:        "intercept_children",
+				Description: "Specifies whether the log entries from the children are intercepted by the sink, and not routed by the sinks of the children",
+				Type:        proto.ColumnType_BOOL,
+			},
 			{
 				Name:        "self_link",
 				Description: "Server-defined URL for the resource.",
@@ -82,6 +94,11 @@ func tableGcpLoggingSink(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("UpdateTime").NullIfZero(),
 			},
+			{
+				Name:        "bigquery_options",
+				Description: "Options that affect sinks exporting data to BigQuery",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "exclusions",
 				Description: "A list of exclusion filters. Log entries that match any of the exclusion filters will not be exported.",
@@ -238,3 +255,16 @@ func getSinkSelfLink(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	selfLink := "https://www.googleapis.com/logging/v2/projects/" + project + "/sinks/" + sink.Name
 	return selfLink, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+// Destinations have the form {service}.googleapis.com/{path}, for example storage.googleapis.com/my-bucket
+func loggingSinkDestinationType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	destination := d.Value.(string)
+	if destination == "" {
+		return nil, nil
+	}
+
+	host, _, _ := strings.Cut(destination, "/")
+	return strings.TrimSuffix(host, ".googleapis.com"), nil
+}
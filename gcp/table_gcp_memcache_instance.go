@@ -0,0 +1,297 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/memcache/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMemcacheInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_memcache_instance",
+		Description: "GCP Memorystore for Memcached Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getMemcacheInstance,
+			Tags:       map[string]string{"service": "memcache", "action": "instances.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMemcacheInstances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "memcache", "action": "instances.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "User provided name for the instance, which is only used for display purposes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Memcached instance (CREATING, READY, UPDATING, DELETING or PERFORMING_MAINTENANCE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "memcache_version",
+				Description: "The major version of Memcached software.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "memcache_full_version",
+				Description: "The full version of memcached server running on this instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "node_count",
+				Description: "Number of nodes in the Memcached instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "node_cpu_count",
+				Description: "Number of cpus per Memcached node.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("NodeConfig.CpuCount"),
+			},
+			{
+				Name:        "node_memory_size_mb",
+				Description: "Memory size in MiB for each Memcached node.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("NodeConfig.MemorySizeMb"),
+			},
+			{
+				Name:        "authorized_network",
+				Description: "The full name of the Google Compute Engine network to which the instance is connected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "discovery_endpoint",
+				Description: "Endpoint for the Discovery API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the instance was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Whether the instance satisfies the zone isolation requirements.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Whether the instance satisfies the zone separation requirements.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "zones",
+				Description: "Zones in which Memcached nodes should be provisioned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reserved_ip_range_id",
+				Description: "The names of the allocated IP address ranges associated with this private service access connection.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "User defined parameters to apply to the memcached process on each node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "maintenance_policy",
+				Description: "The maintenance policy for the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "maintenance_schedule",
+				Description: "Published maintenance schedule.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "memcache_nodes",
+				Description: "List of Memcached nodes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "instance_messages",
+				Description: "List of messages that describe the current state of the Memcached instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Resource labels to represent user-provided metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(memcacheInstanceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(memcacheInstanceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(memcacheInstanceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listMemcacheInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := MemcacheService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_memcache_instance.listMemcacheInstances", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all locations at once
+		// https://cloud.google.com/memorystore/docs/memcached/reference/rest/v1/projects.locations.instances/list
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Instances.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *memcache.ListInstancesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, instance := range page.Instances {
+			d.StreamListItem(ctx, instance)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_memcache_instance.listMemcacheInstances", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMemcacheInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := MemcacheService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_memcache_instance.getMemcacheInstance", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Instances.Get("projects/" + project + "/locations/" + location + "/instances/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_memcache_instance.getMemcacheInstance", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Instance names have the form projects/{project}/locations/{location}/instances/{instance}
+func memcacheInstanceNamePart(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	position := d.Param.(int)
+	if len(parts) <= position {
+		return nil, nil
+	}
+	return parts[position], nil
+}
+
+func memcacheInstanceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://memcache.googleapis.com/" + name}, nil
+}
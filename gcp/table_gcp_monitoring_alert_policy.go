@@ -53,6 +53,11 @@ func tableGcpMonitoringAlert(_ context.Context) *plugin.Table {
 				Description: "How to combine the results of multiple conditions to determine if an incident should be opened.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "severity",
+				Description: "The severity of the incidents opened by the policy (CRITICAL, ERROR or WARNING).",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "creation_record",
 				Description: "A read-only record of the creation of the alerting policy.",
@@ -68,6 +73,11 @@ func tableGcpMonitoringAlert(_ context.Context) *plugin.Table {
 				Description: "A list of conditions for the policy.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "alert_strategy",
+				Description: "Control over how the notification channels in notification_channels are notified when the policy fires, such as the rate limit and the auto-close duration.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "documentation",
 				Description: "Documentation that is included with notifications and incidents related to this policy.",
@@ -0,0 +1,232 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/monitoring/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringAlertPolicyCondition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_alert_policy_condition",
+		Description: "GCP Monitoring Alert Policy Condition",
+		List: &plugin.ListConfig{
+			ParentHydrate: listMonitoringAlertPolicies,
+			Hydrate:       listMonitoringAlertPolicyConditions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "alert_policy_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "monitoring", "action": "alertPolicies.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique identifier of the condition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "A short name or phrase used to identify the condition in dashboards, notifications, and incidents.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.DisplayName"),
+			},
+			{
+				Name:        "alert_policy_name",
+				Description: "The unique identifier of the alert policy the condition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "alert_policy_display_name",
+				Description: "The display name of the alert policy the condition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertPolicy.DisplayName"),
+			},
+			{
+				Name:        "alert_policy_enabled",
+				Description: "Indicates whether the alert policy the condition belongs to is enabled, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AlertPolicy.Enabled"),
+			},
+			{
+				Name:        "condition_type",
+				Description: "The type of the condition (threshold, absent, matched_log, monitoring_query_language, prometheus_query_language or sql).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(monitoringAlertPolicyConditionType),
+			},
+			{
+				Name:        "filter",
+				Description: "The filter that identifies the time series or the log entries the condition applies to, for threshold, absent and matched log conditions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.ConditionThreshold.Filter", "Condition.ConditionAbsent.Filter", "Condition.ConditionMatchedLog.Filter"),
+			},
+			{
+				Name:        "query",
+				Description: "The query evaluated by the condition, for Monitoring Query Language, PromQL and SQL conditions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.ConditionMonitoringQueryLanguage.Query", "Condition.ConditionPrometheusQueryLanguage.Query", "Condition.ConditionSql.Query"),
+			},
+			{
+				Name:        "comparison",
+				Description: "The comparison to apply between the time series and the threshold, for threshold conditions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.ConditionThreshold.Comparison"),
+			},
+			{
+				Name:        "threshold_value",
+				Description: "A value against which to compare the time series, for threshold conditions.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Condition.ConditionThreshold.ThresholdValue"),
+			},
+			{
+				Name:        "duration",
+				Description: "The amount of time that a time series must violate the condition for it to be considered failing.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.ConditionThreshold.Duration", "Condition.ConditionAbsent.Duration", "Condition.ConditionMonitoringQueryLanguage.Duration", "Condition.ConditionPrometheusQueryLanguage.Duration"),
+			},
+			{
+				Name:        "aggregations",
+				Description: "Specifies the alignment of data points in individual time series as well as how to combine the retrieved time series together, for threshold and absent conditions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionThreshold.Aggregations", "Condition.ConditionAbsent.Aggregations"),
+			},
+			{
+				Name:        "trigger",
+				Description: "The number or percent of time series that must fail the condition for the condition to be triggered.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionThreshold.Trigger", "Condition.ConditionAbsent.Trigger", "Condition.ConditionMonitoringQueryLanguage.Trigger"),
+			},
+			{
+				Name:        "notification_channels",
+				Description: "The notification channels of the alert policy the condition belongs to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertPolicy.NotificationChannels"),
+			},
+			{
+				Name:        "condition_threshold",
+				Description: "A condition that compares a time series against a threshold.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionThreshold"),
+			},
+			{
+				Name:        "condition_absent",
+				Description: "A condition that checks that a time series continues to receive new data points.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionAbsent"),
+			},
+			{
+				Name:        "condition_matched_log",
+				Description: "A condition that checks for log messages matching given constraints.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionMatchedLog"),
+			},
+			{
+				Name:        "condition_monitoring_query_language",
+				Description: "A condition that uses the Monitoring Query Language to define alerts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionMonitoringQueryLanguage"),
+			},
+			{
+				Name:        "condition_prometheus_query_language",
+				Description: "A condition that uses the Prometheus query language to define alerts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionPrometheusQueryLanguage"),
+			},
+			{
+				Name:        "condition_sql",
+				Description: "A condition that periodically evaluates a SQL query result.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.ConditionSql"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.DisplayName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Condition.Name").TransformP(locationResourceAkas, "monitoring.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Condition.Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+type monitoringAlertPolicyConditionRow struct {
+	AlertPolicy *monitoring.AlertPolicy
+	Condition   *monitoring.Condition
+}
+
+//// LIST FUNCTION
+
+func listMonitoringAlertPolicyConditions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	alertPolicy := h.Item.(*monitoring.AlertPolicy)
+
+	// Skip the alert policies that do not match the given name
+	alertPolicyName := d.EqualsQualString("alert_policy_name")
+	if alertPolicyName != "" && alertPolicyName != getLastPathElement(alertPolicy.Name) {
+		return nil, nil
+	}
+
+	for _, condition := range alertPolicy.Conditions {
+		d.StreamListItem(ctx, &monitoringAlertPolicyConditionRow{
+			AlertPolicy: alertPolicy,
+			Condition:   condition,
+		})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func monitoringAlertPolicyConditionType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	condition := d.HydrateItem.(*monitoringAlertPolicyConditionRow).Condition
+
+	switch {
+	case condition.ConditionThreshold != nil:
+		return "threshold", nil
+	case condition.ConditionAbsent != nil:
+		return "absent", nil
+	case condition.ConditionMatchedLog != nil:
+		return "matched_log", nil
+	case condition.ConditionMonitoringQueryLanguage != nil:
+		return "monitoring_query_language", nil
+	case condition.ConditionPrometheusQueryLanguage != nil:
+		return "prometheus_query_language", nil
+	case condition.ConditionSql != nil:
+		return "sql", nil
+	}
+	return nil, nil
+}
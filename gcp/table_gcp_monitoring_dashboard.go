@@ -0,0 +1,238 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	monitoring1 "google.golang.org/api/monitoring/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringDashboard(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_dashboard",
+		Description: "GCP Monitoring Dashboard",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getMonitoringDashboard,
+			Tags:       map[string]string{"service": "monitoring", "action": "dashboards.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringDashboards,
+			Tags:    map[string]string{"service": "monitoring", "action": "dashboards.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique identifier of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The mutable, human-readable name of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "layout_type",
+				Description: "The type of layout of the dashboard (grid, mosaic, row or column).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(monitoringDashboardLayoutType),
+			},
+			{
+				Name:        "etag",
+				Description: "A checksum computed by the server based on the value of other fields, used to detect changes of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "layout",
+				Description: "The layout of the dashboard, with its widgets, whatever its layout type.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GridLayout", "MosaicLayout", "RowLayout", "ColumnLayout"),
+			},
+			{
+				Name:        "grid_layout",
+				Description: "Content is arranged with a basic layout that re-flows a simple list of informational elements like widgets or tiles.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "mosaic_layout",
+				Description: "The content is arranged as a grid of tiles, with each content widget occupying one or more grid blocks.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "row_layout",
+				Description: "The content is divided into equally spaced rows and the widgets are arranged horizontally.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "column_layout",
+				Description: "The content is divided into equally spaced columns and the widgets are arranged vertically.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "dashboard_filters",
+				Description: "Filters to reduce the amount of data charted based on the filter criteria.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "Configuration for event annotations to display on the dashboard.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels applied to the dashboard.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "monitoring.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listMonitoringDashboards(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := MonitoringServiceV1(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_dashboard.listMonitoringDashboards", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Dashboards.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *monitoring1.ListDashboardsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, dashboard := range page.Dashboards {
+			d.StreamListItem(ctx, dashboard)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_dashboard.listMonitoringDashboards", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMonitoringDashboard(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := MonitoringServiceV1(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_dashboard.getMonitoringDashboard", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Dashboards.Get("projects/" + project + "/dashboards/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_dashboard.getMonitoringDashboard", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func monitoringDashboardLayoutType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dashboard := d.HydrateItem.(*monitoring1.Dashboard)
+
+	switch {
+	case dashboard.GridLayout != nil:
+		return "grid", nil
+	case dashboard.MosaicLayout != nil:
+		return "mosaic", nil
+	case dashboard.RowLayout != nil:
+		return "row", nil
+	case dashboard.ColumnLayout != nil:
+		return "column", nil
+	}
+	return nil, nil
+}
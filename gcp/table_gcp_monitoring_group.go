@@ -24,6 +24,12 @@ func tableGcpMonitoringGroup(_ context.Context) *plugin.Table {
 			Hydrate: listMonitoringGroup,
 			Tags:    map[string]string{"service": "monitoring", "action": "groups.list"},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listMonitoringGroupMembers,
+				Tags: map[string]string{"service": "monitoring", "action": "groups.members.list"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "name",
@@ -51,6 +57,13 @@ func tableGcpMonitoringGroup(_ context.Context) *plugin.Table {
 				Description: "The name of the group's parent, if it has one.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "members",
+				Description: "The monitored resources that are members of the group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMonitoringGroupMembers,
+				Transform:   transform.FromValue(),
+			},
 
 			// standard steampipe columns
 			{
@@ -167,6 +180,32 @@ func getMonitoringGroup(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return req, nil
 }
 
+func listMonitoringGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*monitoring.Group)
+
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_group.listMonitoringGroupMembers", "service_error", err)
+		return nil, err
+	}
+
+	var members []*monitoring.MonitoredResource
+	resp := service.Projects.Groups.Members.List(group.Name).PageSize(1000)
+	if err := resp.Pages(ctx, func(page *monitoring.ListGroupMembersResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		members = append(members, page.Members...)
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_group.listMonitoringGroupMembers", "api_error", err)
+		return nil, err
+	}
+
+	return members, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func groupInfoToTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,304 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/monitoring/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringMetric(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_metric",
+		Description: "GCP Monitoring Metric",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringMetricTimeSeries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "metric_type", Require: plugin.Required},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<="}},
+				{Name: "alignment_period", Require: plugin.Optional},
+				{Name: "per_series_aligner", Require: plugin.Optional},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			Tags: map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "metric_type",
+				Description: "The type of the metric, for example compute.googleapis.com/instance/cpu/utilization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Metric.Type"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the monitored resource the time series belongs to, for example gce_instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Resource.Type"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The end time of the interval of the data point.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Point.Interval.EndTime"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the interval of the data point. It is the same as the end time for gauge metrics.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Point.Interval.StartTime").NullIfZero(),
+			},
+			{
+				Name:        "value",
+				Description: "The numeric value of the data point, for metrics of type DOUBLE or INT64.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(monitoringMetricPointValue),
+			},
+			{
+				Name:        "bool_value",
+				Description: "The value of the data point, for metrics of type BOOL.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Point.Value.BoolValue"),
+			},
+			{
+				Name:        "string_value",
+				Description: "The value of the data point, for metrics of type STRING.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Point.Value.StringValue"),
+			},
+			{
+				Name:        "distribution_value",
+				Description: "The value of the data point, for metrics of type DISTRIBUTION.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Point.Value.DistributionValue"),
+			},
+			{
+				Name:        "metric_kind",
+				Description: "The metric kind of the time series (GAUGE, DELTA or CUMULATIVE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.MetricKind"),
+			},
+			{
+				Name:        "value_type",
+				Description: "The value type of the time series (BOOL, INT64, DOUBLE, STRING or DISTRIBUTION).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.ValueType"),
+			},
+			{
+				Name:        "unit",
+				Description: "The units in which the metric value is reported.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Unit"),
+			},
+			{
+				Name:        "alignment_period",
+				Description: "The alignment period used to regularize the data points of each time series, for example 300s. Must be set along with per_series_aligner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("alignment_period"),
+			},
+			{
+				Name:        "per_series_aligner",
+				Description: "The approach used to align the data points of each time series, for example ALIGN_MEAN or ALIGN_MAX. Must be set along with alignment_period.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("per_series_aligner"),
+			},
+			{
+				Name:        "filter",
+				Description: "An additional monitoring filter applied to the time series, for example resource.labels.instance_id = \"1234\".",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "metric_labels",
+				Description: "The set of label values that uniquely identify the metric.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TimeSeries.Metric.Labels"),
+			},
+			{
+				Name:        "resource_labels",
+				Description: "The labels of the monitored resource, for example its instance_id and zone.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TimeSeries.Resource.Labels"),
+			},
+			{
+				Name:        "metadata",
+				Description: "The associated monitored resource metadata.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TimeSeries.Metadata"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(monitoringMetricLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project"),
+			},
+		},
+	}
+}
+
+type monitoringMetricPoint struct {
+	TimeSeries *monitoring.TimeSeries
+	Point      *monitoring.Point
+	Project    string
+}
+
+//// LIST FUNCTION
+
+func listMonitoringMetricTimeSeries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_metric.listMonitoringMetricTimeSeries", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	startTime, endTime := monitoringMetricInterval(d.Quals)
+
+	resp := service.Projects.TimeSeries.List("projects/" + project).Filter(buildMonitoringMetricFilter(d)).IntervalStartTime(startTime.Format(time.RFC3339)).IntervalEndTime(endTime.Format(time.RFC3339)).PageSize(*pageSize)
+	if alignmentPeriod := d.EqualsQualString("alignment_period"); alignmentPeriod != "" {
+		resp.AggregationAlignmentPeriod(alignmentPeriod)
+	}
+	if perSeriesAligner := d.EqualsQualString("per_series_aligner"); perSeriesAligner != "" {
+		resp.AggregationPerSeriesAligner(perSeriesAligner)
+	}
+
+	if err := resp.Pages(ctx, func(page *monitoring.ListTimeSeriesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		// Each point of each time series is returned as a row
+		for _, timeSeries := range page.TimeSeries {
+			for _, point := range timeSeries.Points {
+				d.StreamListItem(ctx, &monitoringMetricPoint{
+					TimeSeries: timeSeries,
+					Point:      point,
+					Project:    project,
+				})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_metric.listMonitoringMetricTimeSeries", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func monitoringMetricPointValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value := d.HydrateItem.(*monitoringMetricPoint).Point.Value
+	if value == nil {
+		return nil, nil
+	}
+
+	switch {
+	case value.DoubleValue != nil:
+		return *value.DoubleValue, nil
+	case value.Int64Value != nil:
+		return float64(*value.Int64Value), nil
+	}
+	return nil, nil
+}
+
+// The location of a time series is taken from the labels of its monitored
+// resource, which depend on the resource type
+func monitoringMetricLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource := d.HydrateItem.(*monitoringMetricPoint).TimeSeries.Resource
+	if resource == nil {
+		return "global", nil
+	}
+
+	for _, key := range []string{"zone", "location", "region"} {
+		if location := resource.Labels[key]; location != "" {
+			return location, nil
+		}
+	}
+	return "global", nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The time series API requires an interval; if no timestamp qual is given,
+// the data points of the last hour are returned
+func monitoringMetricInterval(quals plugin.KeyColumnQualMap) (time.Time, time.Time) {
+	endTime := time.Now()
+	var startTime time.Time
+
+	if quals["timestamp"] != nil {
+		for _, q := range quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				startTime, endTime = timestamp, timestamp
+			case ">", ">=":
+				startTime = timestamp
+			case "<", "<=":
+				endTime = timestamp
+			}
+		}
+	}
+
+	// Without a lower bound, return the hour before the end of the interval
+	if startTime.IsZero() {
+		startTime = endTime.Add(-1 * time.Hour)
+	}
+
+	return startTime, endTime
+}
+
+func buildMonitoringMetricFilter(d *plugin.QueryData) string {
+	filterQuals := []filterQualMap{
+		{"metric_type", "metric.type", "string"},
+		{"resource_type", "resource.type", "string"},
+	}
+
+	// IN lists on metric_type or resource_type are ORed together
+	filters := buildQueryFilter(filterQuals, d.EqualsQuals)
+	if extraFilter := d.EqualsQualString("filter"); extraFilter != "" {
+		filters = append(filters, "("+extraFilter+")")
+	}
+
+	return strings.Join(filters, " AND ")
+}
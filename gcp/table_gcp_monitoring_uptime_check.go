@@ -0,0 +1,281 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/monitoring/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringUptimeCheck(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_uptime_check",
+		Description: "GCP Monitoring Uptime Check",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getMonitoringUptimeCheck,
+			Tags:       map[string]string{"service": "monitoring", "action": "uptimeCheckConfigs.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringUptimeChecks,
+			Tags:    map[string]string{"service": "monitoring", "action": "uptimeCheckConfigs.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique identifier of the uptime check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "A human-friendly name for the uptime check configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol of the check (HTTP, HTTPS, TCP or SYNTHETIC).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(monitoringUptimeCheckProtocol),
+			},
+			{
+				Name:        "host",
+				Description: "The host name or IP address checked, for checks of an uptime URL monitored resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitoredResource.Labels.host"),
+			},
+			{
+				Name:        "path",
+				Description: "The path to the page against which to run the HTTP check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HttpCheck.Path"),
+			},
+			{
+				Name:        "port",
+				Description: "The port to the page or the TCP port against which to run the check.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HttpCheck.Port", "TcpCheck.Port"),
+			},
+			{
+				Name:        "request_method",
+				Description: "The HTTP request method to use for the check (GET or POST).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HttpCheck.RequestMethod"),
+			},
+			{
+				Name:        "use_ssl",
+				Description: "If true, use HTTPS instead of HTTP to run the check.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HttpCheck.UseSsl"),
+			},
+			{
+				Name:        "validate_ssl",
+				Description: "Whether to include SSL certificate validation as a part of the uptime check.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HttpCheck.ValidateSsl"),
+			},
+			{
+				Name:        "checker_type",
+				Description: "The type of checkers to use to execute the uptime check (STATIC_IP_CHECKERS or VPC_CHECKERS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "period",
+				Description: "How often, in seconds, the uptime check is performed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timeout",
+				Description: "The maximum amount of time to wait for the request to complete.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "selected_regions",
+				Description: "The list of regions from which the check will be run. If empty, the check is run from all the available regions.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http_check",
+				Description: "Contains information needed to make an HTTP or HTTPS check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tcp_check",
+				Description: "Contains information needed to make a TCP check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "synthetic_monitor",
+				Description: "Specifies a Synthetic Monitor to invoke.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "monitored_resource",
+				Description: "The monitored resource associated with the configuration, for example an uptime URL with its host.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_group",
+				Description: "The group resource associated with the configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "content_matchers",
+				Description: "The content that is expected to appear in the data returned by the target server against which the check is run.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "internal_checkers",
+				Description: "The internal checkers that this check will egress from.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_labels",
+				Description: "User-supplied key/value data to be used for configuring the uptime check.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UserLabels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "monitoring.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listMonitoringUptimeChecks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_uptime_check.listMonitoringUptimeChecks", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.UptimeCheckConfigs.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *monitoring.ListUptimeCheckConfigsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, uptimeCheck := range page.UptimeCheckConfigs {
+			d.StreamListItem(ctx, uptimeCheck)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_uptime_check.listMonitoringUptimeChecks", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMonitoringUptimeCheck(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_uptime_check.getMonitoringUptimeCheck", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.UptimeCheckConfigs.Get("projects/" + project + "/uptimeCheckConfigs/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_monitoring_uptime_check.getMonitoringUptimeCheck", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func monitoringUptimeCheckProtocol(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	uptimeCheck := d.HydrateItem.(*monitoring.UptimeCheckConfig)
+
+	switch {
+	case uptimeCheck.HttpCheck != nil && uptimeCheck.HttpCheck.UseSsl:
+		return "HTTPS", nil
+	case uptimeCheck.HttpCheck != nil:
+		return "HTTP", nil
+	case uptimeCheck.TcpCheck != nil:
+		return "TCP", nil
+	case uptimeCheck.SyntheticMonitor != nil:
+		return "SYNTHETIC", nil
+	}
+	return nil, nil
+}
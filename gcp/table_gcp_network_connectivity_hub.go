@@ -0,0 +1,225 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/networkconnectivity/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpNetworkConnectivityHub(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_network_connectivity_hub",
+		Description: "GCP Network Connectivity Center Hub",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getNetworkConnectivityHub,
+			Tags:       map[string]string{"service": "networkconnectivity", "action": "hubs.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkConnectivityHubs,
+			Tags:    map[string]string{"service": "networkconnectivity", "action": "hubs.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the hub.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "unique_id",
+				Description: "The Google-generated UUID for the hub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the hub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current lifecycle state of the hub (CREATING, ACTIVE, DELETING, ACCEPTING, REJECTING, UPDATING, INACTIVE or OBSOLETE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the hub was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the hub was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "policy_mode",
+				Description: "The policy mode of the hub, which determines how the connectivity between the spokes is configured (PRESET).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preset_topology",
+				Description: "The topology implemented in the hub, when the policy mode is PRESET (MESH or STAR).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "export_psc",
+				Description: "Whether Private Service Connect transitivity is enabled for the hub.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the hub.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "route_tables",
+				Description: "The route tables that belong to the hub.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "routing_vpcs",
+				Description: "The VPC networks associated with the hub's spokes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "spoke_summary",
+				Description: "A summary of the spokes associated with the hub, with the number of spokes per type, state and state reason.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Optional labels in key-value pair format.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "networkconnectivity.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkConnectivityHubs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.listNetworkConnectivityHubs", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Hubs are global resources
+	resp := service.Projects.Locations.Global.Hubs.List("projects/" + project + "/locations/global").PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *networkconnectivity.ListHubsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, hub := range page.Hubs {
+			d.StreamListItem(ctx, hub)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.listNetworkConnectivityHubs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkConnectivityHub(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.getNetworkConnectivityHub", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Global.Hubs.Get("projects/" + project + "/locations/global/hubs/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.getNetworkConnectivityHub", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,262 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/networkconnectivity/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpNetworkConnectivitySpoke(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_network_connectivity_spoke",
+		Description: "GCP Network Connectivity Center Spoke",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getNetworkConnectivitySpoke,
+			Tags:       map[string]string{"service": "networkconnectivity", "action": "spokes.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkConnectivitySpokes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "networkconnectivity", "action": "spokes.list"},
+		},
+		GetMatrixItemFunc: BuildNetworkConnectivityLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the spoke.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "hub_name",
+				Description: "The name of the hub the spoke is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Hub").Transform(lastPathElement),
+			},
+			{
+				Name:        "spoke_type",
+				Description: "The type of resource associated with the spoke (VPN_TUNNEL, INTERCONNECT_ATTACHMENT, ROUTER_APPLIANCE, VPC_NETWORK or PRODUCER_VPC_NETWORK).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current lifecycle state of the spoke (CREATING, ACTIVE, DELETING, ACCEPTING, REJECTING, UPDATING, INACTIVE or OBSOLETE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unique_id",
+				Description: "The Google-generated UUID for the spoke.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the spoke.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group",
+				Description: "The name of the group the spoke belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the spoke was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the spoke was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "hub",
+				Description: "The resource name of the hub the spoke is attached to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the spoke.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "linked_vpn_tunnels",
+				Description: "The VPN tunnels that are associated with the spoke, along with the VPC network they belong to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_interconnect_attachments",
+				Description: "The VLAN attachments that are associated with the spoke, along with the VPC network they belong to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_router_appliance_instances",
+				Description: "The router appliance instances that are associated with the spoke, along with the VPC network they belong to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_vpc_network",
+				Description: "The VPC network that is associated with the spoke, along with its exported IP address ranges.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_producer_vpc_network",
+				Description: "The producer VPC network that is associated with the spoke, along with the peering used to reach it.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reasons",
+				Description: "The reasons for the current state of the spoke, for example why it is pending review or inactive.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Optional labels in key-value pair format.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "networkconnectivity.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkConnectivitySpokes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.listNetworkConnectivitySpokes", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Spokes.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *networkconnectivity.ListSpokesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, spoke := range page.Spokes {
+			d.StreamListItem(ctx, spoke)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.listNetworkConnectivitySpokes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkConnectivitySpoke(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.getNetworkConnectivitySpoke", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Spokes.Get("projects/" + project + "/locations/" + location + "/spokes/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.getNetworkConnectivitySpoke", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
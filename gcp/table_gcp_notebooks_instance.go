@@ -0,0 +1,344 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/notebooks/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpNotebooksInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_notebooks_instance",
+		Description: "GCP Vertex AI Workbench Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getNotebooksInstance,
+			Tags:       map[string]string{"service": "notebooks", "action": "instances.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNotebooksInstances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "notebooks", "action": "instances.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "id",
+				Description: "Unique ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of this instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_state",
+				Description: "Instance health_state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator",
+				Description: "Email address of entity that sent original CreateInstance request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "machine_type",
+				Description: "The machine type of the VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GceSetup.MachineType").Transform(lastPathElement),
+			},
+			{
+				Name:        "disable_public_ip",
+				Description: "If true, no external IP address is assigned to the VM instance.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GceSetup.DisablePublicIp"),
+			},
+			{
+				Name:        "enable_ip_forwarding",
+				Description: "If true, the VM instance can send and receive packets with non-matching destination or source IPs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GceSetup.EnableIpForwarding"),
+			},
+			{
+				Name:        "idle_shutdown_timeout",
+				Description: "Time in seconds after which an idle instance is shut down, as configured by the idle-timeout-seconds metadata.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(notebooksInstanceIdleShutdownTimeout),
+			},
+			{
+				Name:        "service_account",
+				Description: "The email address of the service account the VM instance runs as.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(notebooksInstanceServiceAccount),
+			},
+			{
+				Name:        "network",
+				Description: "The VPC network the VM instance is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(notebooksInstanceNetworkInterface, "Network"),
+			},
+			{
+				Name:        "subnet",
+				Description: "The VPC subnetwork the VM instance is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(notebooksInstanceNetworkInterface, "Subnet"),
+			},
+			{
+				Name:        "disable_proxy_access",
+				Description: "If true, the notebook instance will not register with the proxy.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_third_party_identity",
+				Description: "Flag that specifies that a notebook can be accessed with third party identity provider.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "proxy_uri",
+				Description: "The proxy endpoint that is used to access the Jupyter notebook.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "boot_disk_kms_key",
+				Description: "The KMS key used to encrypt the boot disk, only applicable if the disk encryption is CMEK.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GceSetup.BootDisk.KmsKey"),
+			},
+			{
+				Name:        "create_time",
+				Description: "Instance creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Instance update time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use for Zone Separation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Reserved for future use for Zone Isolation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "gce_setup",
+				Description: "Compute Engine setup for the notebook.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "health_info",
+				Description: "Additional information about instance health.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "instance_owners",
+				Description: "The owner of this instance after creation. Only one owner is supported.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "upgrade_history",
+				Description: "The upgrade history of this instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels to apply to this instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "notebooks.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listNotebooksInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := NotebooksService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_instance.listNotebooksInstances", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all zones at once
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Instances.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *notebooks.ListInstancesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, instance := range page.Instances {
+			d.StreamListItem(ctx, instance)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_instance.listNotebooksInstances", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNotebooksInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := NotebooksService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_instance.getNotebooksInstance", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Instances.Get("projects/" + project + "/locations/" + location + "/instances/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_instance.getNotebooksInstance", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The idle shutdown of Workbench instances is configured through the
+// idle-timeout-seconds metadata of the VM.
+func notebooksInstanceIdleShutdownTimeout(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	instance := d.HydrateItem.(*notebooks.Instance)
+	if instance.GceSetup == nil {
+		return nil, nil
+	}
+
+	timeout, ok := instance.GceSetup.Metadata["idle-timeout-seconds"]
+	if !ok {
+		return nil, nil
+	}
+
+	seconds, err := strconv.ParseInt(timeout, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	return seconds, nil
+}
+
+func notebooksInstanceServiceAccount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	instance := d.HydrateItem.(*notebooks.Instance)
+	if instance.GceSetup == nil || len(instance.GceSetup.ServiceAccounts) == 0 {
+		return nil, nil
+	}
+	return instance.GceSetup.ServiceAccounts[0].Email, nil
+}
+
+// Workbench instances only support a single network interface
+func notebooksInstanceNetworkInterface(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	instance := d.HydrateItem.(*notebooks.Instance)
+	if instance.GceSetup == nil || len(instance.GceSetup.NetworkInterfaces) == 0 {
+		return nil, nil
+	}
+
+	networkInterface := instance.GceSetup.NetworkInterfaces[0]
+	switch d.Param.(string) {
+	case "Network":
+		return networkInterface.Network, nil
+	case "Subnet":
+		return networkInterface.Subnet, nil
+	}
+	return nil, nil
+}
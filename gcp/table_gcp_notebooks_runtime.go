@@ -0,0 +1,296 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	notebooks1 "google.golang.org/api/notebooks/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpNotebooksRuntime(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_notebooks_runtime",
+		Description: "GCP Vertex AI Workbench Managed Notebooks Runtime",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getNotebooksRuntime,
+			Tags:       map[string]string{"service": "notebooks", "action": "runtimes.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNotebooksRuntimes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "notebooks", "action": "runtimes.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the runtime.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "state",
+				Description: "Runtime state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_state",
+				Description: "Runtime health_state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_type",
+				Description: "The type of access mode this instance, e.g. SINGLE_USER or SERVICE_ACCOUNT.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessConfig.AccessType"),
+			},
+			{
+				Name:        "runtime_owner",
+				Description: "The owner of this runtime after creation. A single user email for SINGLE_USER access, or the service account for SERVICE_ACCOUNT access.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessConfig.RuntimeOwner"),
+			},
+			{
+				Name:        "proxy_uri",
+				Description: "The proxy endpoint that is used to access the runtime.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessConfig.ProxyUri"),
+			},
+			{
+				Name:        "machine_type",
+				Description: "The Compute Engine machine type used for runtimes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachine.VirtualMachineConfig.MachineType").Transform(lastPathElement),
+			},
+			{
+				Name:        "internal_ip_only",
+				Description: "If true, runtime will only have internal IP addresses.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualMachine.VirtualMachineConfig.InternalIpOnly"),
+			},
+			{
+				Name:        "idle_shutdown",
+				Description: "Runtime will automatically shutdown after idle_shutdown_time.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SoftwareConfig.IdleShutdown"),
+			},
+			{
+				Name:        "idle_shutdown_timeout",
+				Description: "Time in minutes to wait before shutting down runtime.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SoftwareConfig.IdleShutdownTimeout"),
+			},
+			{
+				Name:        "disable_terminal",
+				Description: "Bool indicating whether JupyterLab terminal will be available or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SoftwareConfig.DisableTerminal"),
+			},
+			{
+				Name:        "network",
+				Description: "The Compute Engine network to be used for machine communications.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachine.VirtualMachineConfig.Network"),
+			},
+			{
+				Name:        "subnet",
+				Description: "The Compute Engine subnetwork to be used for machine communications.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachine.VirtualMachineConfig.Subnet"),
+			},
+			{
+				Name:        "zone",
+				Description: "The zone where the virtual machine is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachine.VirtualMachineConfig.Zone"),
+			},
+			{
+				Name:        "kms_key",
+				Description: "The Cloud KMS resource identifier of the customer-managed encryption key used to protect the runtime disks.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachine.VirtualMachineConfig.EncryptionConfig.KmsKey"),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The user-friendly name of the Managed Compute Engine instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachine.InstanceName"),
+			},
+			{
+				Name:        "migrated",
+				Description: "Bool indicating whether this notebook has been migrated to a Workbench Instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "Runtime creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Runtime update time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "access_config",
+				Description: "The config settings for accessing runtime.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "software_config",
+				Description: "The config settings for software inside the runtime.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "virtual_machine",
+				Description: "Use a Compute Engine VM image to start the managed notebook instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metrics",
+				Description: "Contains Runtime daemon metrics such as Service status and JupyterLab stats.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels to associate with this Managed Notebook or Runtime.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "notebooks.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listNotebooksRuntimes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := NotebooksServiceV1(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_runtime.listNotebooksRuntimes", "service_error", err)
+		return nil, err
+	}
+
+	location := d.EqualsQualString("location")
+	if location == "" {
+		// Wildcard to query all regions at once
+		location = "-"
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Runtimes.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *notebooks1.ListRuntimesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, runtime := range page.Runtimes {
+			d.StreamListItem(ctx, runtime)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_runtime.listNotebooksRuntimes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNotebooksRuntime(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := NotebooksServiceV1(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_runtime.getNotebooksRuntime", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Runtimes.Get("projects/" + project + "/locations/" + location + "/runtimes/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_notebooks_runtime.getNotebooksRuntime", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
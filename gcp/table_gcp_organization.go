@@ -62,6 +62,13 @@ func tableGcpOrganization(_ context.Context) *plugin.Table {
 				Hydrate:     getOrganizationContacts,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the organization.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getOrganizationIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -152,6 +159,30 @@ func getOrganizationContacts(ctx context.Context, d *plugin.QueryData, h *plugin
 	return contacts, nil
 }
 
+func getOrganizationIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	organizationName := h.Item.(*cloudresourcemanager.Organization).Name
+
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_organization.getOrganizationIamPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	rb := &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{
+			RequestedPolicyVersion: 3,
+		},
+	}
+	resp, err := service.Organizations.GetIamPolicy(organizationName, rb).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_organization.getOrganizationIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func getOrganizationAka(ctx context.Context, d *transform.TransformData) (interface{}, error) {
@@ -82,6 +82,13 @@ func tableGcpOrganizationProject(_ context.Context) *plugin.Table {
 				Hydrate:     getProjectBillingInfo,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the project.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getProjectIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -83,6 +83,13 @@ func tableGcpProject(_ context.Context) *plugin.Table {
 				Hydrate:     getProjectBillingInfo,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the project.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getProjectIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -220,6 +227,30 @@ func getProjectBillingInfo(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	return resp, nil
 }
 
+func getProjectIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_project.getProjectIamPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId := h.Item.(*cloudresourcemanager.Project).ProjectId
+
+	rb := &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{
+			RequestedPolicyVersion: 3,
+		},
+	}
+	resp, err := service.Projects.GetIamPolicy(projectId, rb).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_project.getProjectIamPolicy", "api_err", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
 func projectSelfLink(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	data := d.HydrateItem.(*cloudresourcemanager.Project)
 	selfLink := "https://cloudresourcemanager.googleapis.com/v1/projects/" + data.Name
@@ -48,8 +48,37 @@ func tableGcpProjectService(_ context.Context) *plugin.Table {
 				Description: "The resource name of the consumer",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "display_name",
+				Description: "The human readable name of the service, for example Compute Engine API.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.Title"),
+			},
+			{
+				Name:        "documentation_summary",
+				Description: "A short summary of what the service does.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config.Documentation.Summary"),
+			},
+			{
+				Name:        "usage_requirements",
+				Description: "The requirements that must be met before the service can be enabled, such as the acceptance of its terms of service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Config.Usage.Requirements"),
+			},
+			{
+				Name:        "config",
+				Description: "The configuration of the service, including its APIs, endpoints, authentication, documentation, monitored resources and quota.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
 			{
 				Name:        "akas",
 				Description: ColumnDescriptionAkas,
@@ -0,0 +1,271 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/pubsub/v1"
+)
+
+func tableGcpPubSubSchema(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_pubsub_schema",
+		Description: "GCP Pub/Sub Schema",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getPubSubSchema,
+			Tags:       map[string]string{"service": "pubsub", "action": "schemas.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPubSubSchemas,
+			Tags:    map[string]string{"service": "pubsub", "action": "schemas.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listPubSubSchemaRevisions,
+				Tags: map[string]string{"service": "pubsub", "action": "schemas.listRevisions"},
+			},
+			{
+				Func: getPubSubSchemaIamPolicy,
+				Tags: map[string]string{"service": "pubsub", "action": "schemas.getIamPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the schema definition (PROTOCOL_BUFFER or AVRO).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision_id",
+				Description: "The revision ID of the latest revision of the schema.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision_create_time",
+				Description: "The timestamp that the latest revision was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RevisionCreateTime").NullIfZero(),
+			},
+			{
+				Name:        "definition",
+				Description: "The definition of the schema. This should contain a string representing the full definition of the schema that is a valid schema definition of the type specified in `type`.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(pubsubSchemaSelfLink),
+			},
+			{
+				Name:        "revisions",
+				Description: "The revisions of the schema, along with their creation time.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listPubSubSchemaRevisions,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources. A `Policy` is a collection of `bindings`. A `binding` binds one or more `members` to a single `role`. Members can be user accounts, service accounts, Google groups, and domains (such as G Suite). A `role` is a named list of permissions; each `role` can be an IAM predefined role or a user-created custom role. For some types of Google Cloud resources, a `binding` can also specify a `condition`, which is a logical expression that allows access to a resource only if the expression evaluates to `true`.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPubSubSchemaIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(pubsubSchemaTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pubsubSchemaTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPubSubSchemas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := PubsubService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.listPubSubSchemas", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The FULL view is required to get the definition of the schemas
+	resp := service.Projects.Schemas.List("projects/" + project).View("FULL").PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *pubsub.ListSchemasResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, schema := range page.Schemas {
+			d.StreamListItem(ctx, schema)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.listPubSubSchemas", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPubSubSchema(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := PubsubService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.getPubSubSchema", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Schemas.Get("projects/" + project + "/schemas/" + name).View("FULL").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.getPubSubSchema", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func listPubSubSchemaRevisions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	schema := h.Item.(*pubsub.Schema)
+
+	// Create Service Connection
+	service, err := PubsubService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.listPubSubSchemaRevisions", "service_error", err)
+		return nil, err
+	}
+
+	var revisions []map[string]interface{}
+	resp := service.Projects.Schemas.ListRevisions(schema.Name).View("BASIC")
+	if err := resp.Pages(ctx, func(page *pubsub.ListSchemaRevisionsResponse) error {
+		for _, revision := range page.Schemas {
+			revisions = append(revisions, map[string]interface{}{
+				"revision_id":          revision.RevisionId,
+				"revision_create_time": revision.RevisionCreateTime,
+			})
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.listPubSubSchemaRevisions", "api_error", err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func getPubSubSchemaIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	schema := h.Item.(*pubsub.Schema)
+
+	// Create Service Connection
+	service, err := PubsubService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.getPubSubSchemaIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Schemas.GetIamPolicy(schema.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_schema.getPubSubSchemaIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func pubsubSchemaTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	schema := d.HydrateItem.(*pubsub.Schema)
+	param := d.Param.(string)
+
+	splittedTitle := strings.Split(schema.Name, "/")
+
+	turbotData := map[string]interface{}{
+		"Project": splittedTitle[1],
+		"Akas":    []string{"gcp://pubsub.googleapis.com/" + schema.Name},
+	}
+
+	return turbotData[param], nil
+}
+
+func pubsubSchemaSelfLink(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*pubsub.Schema)
+	selfLink := "https://pubsub.googleapis.com/v1/" + data.Name
+
+	return selfLink, nil
+}
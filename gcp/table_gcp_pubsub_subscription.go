@@ -135,6 +135,47 @@ func tableGcpPubSubSubscription(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("RetryPolicy.MinimumBackoff"),
 			},
+			{
+				Name:        "state",
+				Description: "An output-only field indicating whether or not the subscription can receive messages (ACTIVE or RESOURCE_ERROR).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detached",
+				Description: "Indicates whether the subscription is detached from its topic. Detached subscriptions don't receive messages from their topic and don't retain any backlog.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_exactly_once_delivery",
+				Description: "If true, Pub/Sub provides exactly once delivery guarantees for the subscription.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "topic_message_retention_duration",
+				Description: "Indicates the minimum duration for which a message is retained after it is published to the subscription's topic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "delivery_type",
+				Description: "The way messages are delivered to the subscriber (PULL, PUSH, BIGQUERY or CLOUD_STORAGE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(pubsubSubscriptionDeliveryType),
+			},
+			{
+				Name:        "push_config",
+				Description: "If push delivery is used with this subscription, this field is used to configure it.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "bigquery_config",
+				Description: "If delivery to BigQuery is used with this subscription, this field is used to configure it.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_storage_config",
+				Description: "If delivery to Google Cloud Storage is used with this subscription, this field is used to configure it.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "iam_policy",
 				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources. A `Policy` is a collection of `bindings`. A `binding` binds one or more `members` to a single `role`. Members can be user accounts, service accounts, Google groups, and domains (such as G Suite). A `role` is a named list of permissions; each `role` can be an IAM predefined role or a user-created custom role. For some types of Google Cloud resources, a `binding` can also specify a `condition`, which is a logical expression that allows access to a resource only if the expression evaluates to `true`.",
@@ -308,3 +349,18 @@ func pubsubSubscriptionSelfLink(_ context.Context, d *transform.TransformData) (
 
 	return selfLink, nil
 }
+
+func pubsubSubscriptionDeliveryType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subscription := d.HydrateItem.(*pubsub.Subscription)
+
+	// Pull subscriptions are returned with an empty push configuration
+	switch {
+	case subscription.PushConfig != nil && subscription.PushConfig.PushEndpoint != "":
+		return "PUSH", nil
+	case subscription.BigqueryConfig != nil:
+		return "BIGQUERY", nil
+	case subscription.CloudStorageConfig != nil:
+		return "CLOUD_STORAGE", nil
+	}
+	return "PULL", nil
+}
@@ -55,6 +55,49 @@ func tableGcpPubSubTopic(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("MessageStoragePolicy.AllowedPersistenceRegions"),
 			},
+			{
+				Name:        "message_storage_policy_enforce_in_transit",
+				Description: "If true, allowed_persistence_regions is also used to enforce in-transit guarantees for messages.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MessageStoragePolicy.EnforceInTransit"),
+			},
+			{
+				Name:        "message_retention_duration",
+				Description: "Indicates the minimum duration to retain a message after it is published to the topic. If not set, messages are retained as long as they are part of a subscription backlog.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "An output-only field indicating the state of the topic (ACTIVE or INGESTION_RESOURCE_ERROR).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "schema_name",
+				Description: "The name of the schema that messages published to the topic should be validated against.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemaSettings.Schema"),
+			},
+			{
+				Name:        "schema_encoding",
+				Description: "The encoding of messages validated against the schema (JSON or BINARY).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemaSettings.Encoding"),
+			},
+			{
+				Name:        "schema_settings",
+				Description: "Settings for validating messages published against a schema, including the range of schema revisions that messages can be validated against.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ingestion_data_source_settings",
+				Description: "Settings for ingestion from a data source into this topic.",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "iam_policy",
 				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources. A `Policy` is a collection of `bindings`. A `binding` binds one or more `members` to a single `role`. Members can be user accounts, service accounts, Google groups, and domains (such as G Suite). A `role` is a named list of permissions; each `role` can be an IAM predefined role or a user-created custom role. For some types of Google Cloud resources, a `binding` can also specify a `condition`, which is a logical expression that allows access to a resource only if the expression evaluates to `true`.",
@@ -0,0 +1,261 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/recommender/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpRecommenderInsight(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_recommender_insight",
+		Description: "GCP Recommender Insight",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "insight_type_id", "location"}),
+			Hydrate:    getRecommenderInsight,
+			Tags:       map[string]string{"service": "recommender", "action": "insights.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRecommenderInsights,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "insight_type_id", Require: plugin.Required},
+				{Name: "location", Require: plugin.Required},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "insight_subtype", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "recommender", "action": "insights.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the insight.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "insight_type_id",
+				Description: "The ID of the insight type that generated the insight, for example google.iam.policy.Insight.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "description",
+				Description: "The free-form human readable summary of the insight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the insight (COST, SECURITY, PERFORMANCE, MANAGEABILITY, SUSTAINABILITY or RELIABILITY).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "insight_subtype",
+				Description: "The subtype of the insight within the insight type, for example PERMISSIONS_USAGE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the insight (LOW, MEDIUM, HIGH or CRITICAL).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the insight (ACTIVE, ACCEPTED or DISMISSED).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StateInfo.State"),
+			},
+			{
+				Name:        "observation_period",
+				Description: "The period of the observations the insight is based on, for example 7776000s for 90 days.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_refresh_time",
+				Description: "The time the insight was last refreshed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastRefreshTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "The fingerprint of the insight, used to update its state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the insight.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "target_resources",
+				Description: "The full resource names of the resources the insight is about.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "state_metadata",
+				Description: "The metadata set on the state of the insight when it was accepted.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StateInfo.StateMetadata"),
+			},
+			{
+				Name:        "associated_recommendations",
+				Description: "The recommendations derived from the insight.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "content",
+				Description: "The details of the insight, specific to the insight type, such as the permissions exercised by a member.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "recommender.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRecommenderInsights(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	insightTypeId := d.EqualsQualString("insight_type_id")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if insightTypeId == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := RecommenderService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_recommender_insight.listRecommenderInsights", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	filterQuals := []filterQualMap{
+		{"state", "stateInfo.state", "string"},
+		{"severity", "severity", "string"},
+		{"insight_subtype", "insightSubtype", "string"},
+	}
+
+	filters := buildQueryFilter(filterQuals, d.EqualsQuals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " AND ")
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	parent := "projects/" + project + "/locations/" + location + "/insightTypes/" + insightTypeId
+	resp := service.Projects.Locations.InsightTypes.Insights.List(parent).Filter(filterString).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *recommender.GoogleCloudRecommenderV1ListInsightsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, insight := range page.Insights {
+			d.StreamListItem(ctx, insight)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		// The insight type is not available in the location
+		if isIgnorableError([]string{"404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("gcp_recommender_insight.listRecommenderInsights", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRecommenderInsight(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	insightTypeId := d.EqualsQualString("insight_type_id")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || insightTypeId == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := RecommenderService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_recommender_insight.getRecommenderInsight", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.InsightTypes.Insights.Get("projects/" + project + "/locations/" + location + "/insightTypes/" + insightTypeId + "/insights/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_recommender_insight.getRecommenderInsight", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,302 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/recommender/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpRecommenderRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_recommender_recommendation",
+		Description: "GCP Recommender Recommendation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "recommender_id", "location"}),
+			Hydrate:    getRecommenderRecommendation,
+			Tags:       map[string]string{"service": "recommender", "action": "recommendations.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRecommenderRecommendations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "recommender_id", Require: plugin.Required},
+				{Name: "location", Require: plugin.Required},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "priority", Require: plugin.Optional},
+				{Name: "recommender_subtype", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "recommender", "action": "recommendations.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "recommender_id",
+				Description: "The ID of the recommender that generated the recommendation, for example google.compute.instance.IdleResourceRecommender.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "description",
+				Description: "The free-form human readable summary of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommender_subtype",
+				Description: "The subtype of the recommendation within the recommender, for example STOP_VM or REMOVE_ROLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the recommendation, from P1 (highest) to P4.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the recommendation (ACTIVE, CLAIMED, SUCCEEDED, FAILED or DISMISSED).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StateInfo.State"),
+			},
+			{
+				Name:        "primary_impact_category",
+				Description: "The category of the primary impact of the recommendation (COST, SECURITY, PERFORMANCE, MANAGEABILITY, SUSTAINABILITY or RELIABILITY).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrimaryImpact.Category"),
+			},
+			{
+				Name:        "primary_impact_cost",
+				Description: "The projected cost change of the recommendation over the projection duration. A negative value is a saving.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("PrimaryImpact.CostProjection.Cost").Transform(recommenderMoneyAmount),
+			},
+			{
+				Name:        "primary_impact_cost_currency",
+				Description: "The currency of the projected cost change of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrimaryImpact.CostProjection.Cost.CurrencyCode"),
+			},
+			{
+				Name:        "primary_impact_cost_duration",
+				Description: "The duration of the cost projection, for example 2592000s for 30 days.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrimaryImpact.CostProjection.Duration"),
+			},
+			{
+				Name:        "last_refresh_time",
+				Description: "The time the recommendation was last refreshed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastRefreshTime").NullIfZero(),
+			},
+			{
+				Name:        "xor_group_id",
+				Description: "The group of mutually exclusive recommendations the recommendation belongs to. Only one recommendation of a group can be applied.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "The fingerprint of the recommendation, used to update its state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "target_resources",
+				Description: "The full resource names of the resources the recommendation applies to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "state_metadata",
+				Description: "The metadata set on the state of the recommendation when it was claimed, marked succeeded or marked failed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StateInfo.StateMetadata"),
+			},
+			{
+				Name:        "primary_impact",
+				Description: "The primary impact of applying the recommendation, such as its cost, security or sustainability projection.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_impact",
+				Description: "The additional impacts of applying the recommendation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "associated_insights",
+				Description: "The insights that led to the recommendation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "content",
+				Description: "The content of the recommendation, including the operations to apply it and an overview specific to the recommender.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "recommender.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRecommenderRecommendations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	recommenderId := d.EqualsQualString("recommender_id")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if recommenderId == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := RecommenderService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_recommender_recommendation.listRecommenderRecommendations", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	filterQuals := []filterQualMap{
+		{"state", "stateInfo.state", "string"},
+		{"priority", "priority", "string"},
+		{"recommender_subtype", "recommenderSubtype", "string"},
+	}
+
+	filters := buildQueryFilter(filterQuals, d.EqualsQuals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " AND ")
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	parent := "projects/" + project + "/locations/" + location + "/recommenders/" + recommenderId
+	resp := service.Projects.Locations.Recommenders.Recommendations.List(parent).Filter(filterString).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *recommender.GoogleCloudRecommenderV1ListRecommendationsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, recommendation := range page.Recommendations {
+			d.StreamListItem(ctx, recommendation)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		// The recommender is not available in the location
+		if isIgnorableError([]string{"404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("gcp_recommender_recommendation.listRecommenderRecommendations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRecommenderRecommendation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	recommenderId := d.EqualsQualString("recommender_id")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || recommenderId == "" || location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := RecommenderService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_recommender_recommendation.getRecommenderRecommendation", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Recommenders.Recommendations.Get("projects/" + project + "/locations/" + location + "/recommenders/" + recommenderId + "/recommendations/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_recommender_recommendation.getRecommenderRecommendation", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// recommenderMoneyAmount converts a money value, made of whole units and
+// nano units of the same sign, to a decimal amount
+func recommenderMoneyAmount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	money, ok := d.Value.(*recommender.GoogleTypeMoney)
+	if !ok || money == nil {
+		return nil, nil
+	}
+	return float64(money.Units) + float64(money.Nanos)/1e9, nil
+}
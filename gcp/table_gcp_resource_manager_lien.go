@@ -0,0 +1,170 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpResourceManagerLien(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_resource_manager_lien",
+		Description: "GCP Resource Manager Lien",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getResourceManagerLien,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "liens.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listResourceManagerLiens,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "liens.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A system-generated unique identifier for the lien.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "parent",
+				Description: "A reference to the resource the lien is attached to, in the form projects/{project_number}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "origin",
+				Description: "A stable, user-visible string identifying the origin of the lien, intended to be inspected programmatically.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "Concise user-visible strings indicating why an action cannot be performed on a resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation time of the lien.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "restrictions",
+				Description: "The types of operations which should be blocked as a result of the lien, for example resourcemanager.projects.delete.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudresourcemanager.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerLiens(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_lien.listResourceManagerLiens", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Liens.List().Parent("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudresourcemanager3.ListLiensResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, lien := range page.Liens {
+			d.StreamListItem(ctx, lien)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_lien.listResourceManagerLiens", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceManagerLien(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_lien.getResourceManagerLien", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Liens.Get("liens/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_lien.getResourceManagerLien", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
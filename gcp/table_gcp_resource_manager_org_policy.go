@@ -0,0 +1,335 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpResourceManagerOrgPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_resource_manager_org_policy",
+		Description: "GCP Resource Manager Organization Policy",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceManagerOrgPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "resource", Require: plugin.Optional},
+				{Name: "constraint_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "resourcemanager", "action": "listAvailableOrgPolicyConstraints"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getResourceManagerOrgPolicy,
+				Tags: map[string]string{"service": "resourcemanager", "action": "getOrgPolicy"},
+			},
+			{
+				Func: getResourceManagerEffectiveOrgPolicy,
+				Tags: map[string]string{"service": "resourcemanager", "action": "getEffectiveOrgPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "constraint",
+				Description: "The name of the constraint, in the form constraints/{constraint_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Constraint.Name"),
+			},
+			{
+				Name:        "constraint_id",
+				Description: "The ID of the constraint, for example compute.vmExternalIpAccess.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Constraint.Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "resource",
+				Description: "The resource the policies are evaluated for, in the form projects/{project_id}, folders/{folder_id} or organizations/{organization_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The human readable name of the constraint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Constraint.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "Detailed description of what the constraint controls as well as how and where it is enforced.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Constraint.Description"),
+			},
+			{
+				Name:        "constraint_type",
+				Description: "The type of the constraint, either boolean or list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(resourceManagerOrgPolicyConstraintType),
+			},
+			{
+				Name:        "constraint_default",
+				Description: "The evaluation behavior of the constraint in the absence of a policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Constraint.ConstraintDefault"),
+			},
+			{
+				Name:        "is_set",
+				Description: "Whether a policy is set for the constraint directly on the resource.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getResourceManagerOrgPolicy,
+				Transform:   transform.FromField("UpdateTime").Transform(resourceManagerOrgPolicyIsSet),
+			},
+			{
+				Name:        "inherit_from_parent",
+				Description: "Whether the list policy set on the resource is merged with the policies of its ancestors.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getResourceManagerOrgPolicy,
+				Transform:   transform.FromField("ListPolicy.InheritFromParent"),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time stamp the policy set on the resource was previously updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getResourceManagerOrgPolicy,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "enforced",
+				Description: "For boolean constraints, whether the constraint is enforced on the resource, taking inheritance into account.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getResourceManagerEffectiveOrgPolicy,
+				Transform:   transform.FromField("BooleanPolicy.Enforced"),
+			},
+			{
+				Name:        "all_values",
+				Description: "For list constraints, whether all values are allowed or denied on the resource, taking inheritance into account.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getResourceManagerEffectiveOrgPolicy,
+				Transform:   transform.FromField("ListPolicy.AllValues"),
+			},
+			{
+				Name:        "allowed_values",
+				Description: "For list constraints, the values allowed on the resource, taking inheritance into account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerEffectiveOrgPolicy,
+				Transform:   transform.FromField("ListPolicy.AllowedValues"),
+			},
+			{
+				Name:        "denied_values",
+				Description: "For list constraints, the values denied on the resource, taking inheritance into account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerEffectiveOrgPolicy,
+				Transform:   transform.FromField("ListPolicy.DeniedValues"),
+			},
+			{
+				Name:        "policy",
+				Description: "The policy set for the constraint directly on the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerOrgPolicy,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "effective_policy",
+				Description: "The effective policy of the constraint on the resource, computed by merging the policies of the resource hierarchy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerEffectiveOrgPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Constraint.DisplayName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(resourceManagerOrgPolicyAkas),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type orgPolicyConstraintInfo struct {
+	Resource   string
+	Constraint *cloudresourcemanager.Constraint
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerOrgPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_org_policy.listResourceManagerOrgPolicies", "service_error", err)
+		return nil, err
+	}
+
+	// Evaluate the policies for the connection's project, unless another resource is given
+	resource := d.EqualsQualString("resource")
+	if resource == "" {
+		projectId, err := getProject(ctx, d, h)
+		if err != nil {
+			return nil, err
+		}
+		resource = "projects/" + projectId.(string)
+	}
+
+	constraintId := d.EqualsQualString("constraint_id")
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	rb := &cloudresourcemanager.ListAvailableOrgPolicyConstraintsRequest{
+		PageSize: *types.Int64(1000),
+	}
+
+	streamConstraints := func(page *cloudresourcemanager.ListAvailableOrgPolicyConstraintsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, constraint := range page.Constraints {
+			if constraintId != "" && "constraints/"+constraintId != constraint.Name {
+				continue
+			}
+			d.StreamListItem(ctx, &orgPolicyConstraintInfo{resource, constraint})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	switch {
+	case strings.HasPrefix(resource, "organizations/"):
+		err = service.Organizations.ListAvailableOrgPolicyConstraints(resource, rb).Pages(ctx, streamConstraints)
+	case strings.HasPrefix(resource, "folders/"):
+		err = service.Folders.ListAvailableOrgPolicyConstraints(resource, rb).Pages(ctx, streamConstraints)
+	case strings.HasPrefix(resource, "projects/"):
+		err = service.Projects.ListAvailableOrgPolicyConstraints(resource, rb).Pages(ctx, streamConstraints)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_org_policy.listResourceManagerOrgPolicies", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceManagerOrgPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	info := h.Item.(*orgPolicyConstraintInfo)
+
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_org_policy.getResourceManagerOrgPolicy", "service_error", err)
+		return nil, err
+	}
+
+	rb := &cloudresourcemanager.GetOrgPolicyRequest{
+		Constraint: info.Constraint.Name,
+	}
+
+	// An empty policy is returned if no policy is set on the resource
+	var policy *cloudresourcemanager.OrgPolicy
+	switch {
+	case strings.HasPrefix(info.Resource, "organizations/"):
+		policy, err = service.Organizations.GetOrgPolicy(info.Resource, rb).Do()
+	case strings.HasPrefix(info.Resource, "folders/"):
+		policy, err = service.Folders.GetOrgPolicy(info.Resource, rb).Do()
+	default:
+		policy, err = service.Projects.GetOrgPolicy(info.Resource, rb).Do()
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_org_policy.getResourceManagerOrgPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func getResourceManagerEffectiveOrgPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	info := h.Item.(*orgPolicyConstraintInfo)
+
+	// Create Service Connection
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_org_policy.getResourceManagerEffectiveOrgPolicy", "service_error", err)
+		return nil, err
+	}
+
+	rb := &cloudresourcemanager.GetEffectiveOrgPolicyRequest{
+		Constraint: info.Constraint.Name,
+	}
+
+	var policy *cloudresourcemanager.OrgPolicy
+	switch {
+	case strings.HasPrefix(info.Resource, "organizations/"):
+		policy, err = service.Organizations.GetEffectiveOrgPolicy(info.Resource, rb).Do()
+	case strings.HasPrefix(info.Resource, "folders/"):
+		policy, err = service.Folders.GetEffectiveOrgPolicy(info.Resource, rb).Do()
+	default:
+		policy, err = service.Projects.GetEffectiveOrgPolicy(info.Resource, rb).Do()
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_resource_manager_org_policy.getResourceManagerEffectiveOrgPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func resourceManagerOrgPolicyConstraintType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	constraint := d.HydrateItem.(*orgPolicyConstraintInfo).Constraint
+
+	switch {
+	case constraint.BooleanConstraint != nil:
+		return "boolean", nil
+	case constraint.ListConstraint != nil:
+		return "list", nil
+	}
+	return nil, nil
+}
+
+// A policy set on the resource always has an update time
+func resourceManagerOrgPolicyIsSet(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return types.SafeString(d.Value) != "", nil
+}
+
+func resourceManagerOrgPolicyAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	info := d.HydrateItem.(*orgPolicyConstraintInfo)
+	return []string{"gcp://cloudresourcemanager.googleapis.com/" + info.Resource + "/policies/" + getLastPathElement(info.Constraint.Name)}, nil
+}
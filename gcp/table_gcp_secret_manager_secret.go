@@ -22,6 +22,12 @@ func tableGcpSecretManagerSecret(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listGcpSecretManagerSecrets,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getGcpSecretManagerSecretIamPolicy,
+				Tags: map[string]string{"service": "secretmanager", "action": "secrets.getIamPolicy"},
+			},
+		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "name",
@@ -43,7 +49,7 @@ func tableGcpSecretManagerSecret(_ context.Context) *plugin.Table {
 				Name:        "ttl",
 				Description: "The TTL of the secret.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("TTL"),
+				Transform:   transform.FromField("Ttl"),
 			},
 			{
 				Name:        "expire_time",
@@ -51,6 +57,29 @@ func tableGcpSecretManagerSecret(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("ExpireTime").Transform(transform.NullIfZeroValue),
 			},
+			{
+				Name:        "next_rotation_time",
+				Description: "Timestamp in UTC at which the secret is scheduled to rotate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Rotation.NextRotationTime").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "rotation_period",
+				Description: "The duration between rotation notifications.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rotation.RotationPeriod"),
+			},
+			{
+				Name:        "version_destroy_ttl",
+				Description: "The time a secret version is disabled for before it is destroyed. If not set, secret versions are destroyed immediately.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS key used to encrypt the secret payloads, if the secret is encrypted with a customer-managed encryption key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomerManagedEncryption.KmsKeyName"),
+			},
 			{
 				Name:        "replication",
 				Description: "The replication policy of the secret.",
@@ -76,6 +105,18 @@ func tableGcpSecretManagerSecret(_ context.Context) *plugin.Table {
 				Description: "Mapping from version alias to version name.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "rotation",
+				Description: "The rotation time and period of the secret.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the secret.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGcpSecretManagerSecretIamPolicy,
+				Transform:   transform.FromValue(),
+			},
 
 			// Standard steampipe columns
 			{
@@ -185,6 +226,25 @@ func getGcpSecretManagerSecret(ctx context.Context, d *plugin.QueryData, h *plug
 	return op, nil
 }
 
+func getGcpSecretManagerSecretIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	secret := h.Item.(*secretmanager.Secret)
+
+	// Create Service Connection
+	service, err := SecretManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_secret_manager_secret.getGcpSecretManagerSecretIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Secrets.GetIamPolicy(secret.Name).OptionsRequestedPolicyVersion(3).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_secret_manager_secret.getGcpSecretManagerSecretIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func secretManagerSecretNameToAkas(ctx context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,204 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/secretmanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSecretManagerSecretVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_secret_manager_secret_version",
+		Description: "GCP Secret Manager Secret Version",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"secret_name", "name"}),
+			Hydrate:    getGcpSecretManagerSecretVersion,
+			Tags:       map[string]string{"service": "secretmanager", "action": "versions.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpSecretManagerSecrets,
+			Hydrate:       listGcpSecretManagerSecretVersions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "secret_name", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "secretmanager", "action": "versions.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the secret version. Version IDs are incremented for each subsequent version of the secret.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "secret_name",
+				Description: "The name of the secret the version belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the secret version, one of ENABLED, DISABLED or DESTROYED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the secret version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "destroy_time",
+				Description: "The time this secret version was destroyed. Only present if state is DESTROYED.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DestroyTime").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "scheduled_destroy_time",
+				Description: "The time this secret version is scheduled to be destroyed at, if the secret has a version destroy TTL.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduledDestroyTime").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "etag",
+				Description: "Etag of the currently stored secret version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "client_specified_payload_checksum",
+				Description: "True if payload checksum was specified when the secret version was added.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the secret version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "customer_managed_encryption",
+				Description: "The customer-managed encryption status of the secret version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "replication_status",
+				Description: "The replication status of the secret version.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "secretmanager.googleapis.com"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpSecretManagerSecretVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	secret := h.Item.(*secretmanager.Secret)
+
+	// Minimize the API call with the given secret name
+	secretName := d.EqualsQualString("secret_name")
+	if secretName != "" && secretName != getLastPathElement(secret.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SecretManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_secret_manager_secret_version.listGcpSecretManagerSecretVersions", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil && *limit < pageSize {
+		pageSize = *limit
+	}
+
+	resp := service.Projects.Secrets.Versions.List(secret.Name).PageSize(pageSize)
+	if state := d.EqualsQualString("state"); state != "" {
+		resp = resp.Filter("state:" + state)
+	}
+	if err := resp.Pages(ctx, func(page *secretmanager.ListSecretVersionsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, version := range page.Versions {
+			d.StreamListItem(ctx, version)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_secret_manager_secret_version.listGcpSecretManagerSecretVersions", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpSecretManagerSecretVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	secretName := d.EqualsQualString("secret_name")
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if secretName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SecretManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_secret_manager_secret_version.getGcpSecretManagerSecretVersion", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Secrets.Versions.Get("projects/" + project + "/secrets/" + secretName + "/versions/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_secret_manager_secret_version.getGcpSecretManagerSecretVersion", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
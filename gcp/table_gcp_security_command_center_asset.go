@@ -0,0 +1,225 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/securitycenter/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSecurityCommandCenterAsset(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_security_command_center_asset",
+		Description: "GCP Security Command Center Asset",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCommandCenterAssets,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "securitycenter", "action": "assets.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The relative resource name of the asset.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.Name"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The full resource name of the Google Cloud resource the asset represents.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceName"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the Google Cloud resource, for example google.compute.Instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceType"),
+			},
+			{
+				Name:        "resource_display_name",
+				Description: "The user defined display name for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceDisplayName"),
+			},
+			{
+				Name:        "resource_parent",
+				Description: "The full resource name of the immediate parent of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceParent"),
+			},
+			{
+				Name:        "resource_project",
+				Description: "The full resource name of the project the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceProject"),
+			},
+			{
+				Name:        "resource_project_display_name",
+				Description: "The user defined display name for the project of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceProjectDisplayName"),
+			},
+			{
+				Name:        "canonical_name",
+				Description: "The canonical name of the asset, in the form projects/{project_number}/assets/{asset_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.CanonicalName"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the asset was created in Security Command Center.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Asset.CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time at which the asset was last updated or added in Security Command Center.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Asset.UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "resource_owners",
+				Description: "Owners of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceOwners"),
+			},
+			{
+				Name:        "resource_properties",
+				Description: "Resource managed properties, as key-value pairs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Asset.ResourceProperties"),
+			},
+			{
+				Name:        "security_marks",
+				Description: "User specified security marks of the asset.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Asset.SecurityMarks.Marks"),
+			},
+			{
+				Name:        "iam_policy",
+				Description: "The IAM policy associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Asset.IamPolicy.PolicyBlob").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "folders",
+				Description: "The folders the resource belongs to, from the closest folder to the furthest.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.Folders"),
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization the assets are listed from. Only set when the organization_id is given in the query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Asset.SecurityCenterProperties.ResourceDisplayName", "Asset.Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Asset.Name").TransformP(locationResourceAkas, "securitycenter.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCommandCenterAssets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SecurityCenterService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_asset.listSecurityCommandCenterAssets", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	filter := ""
+	if resourceType := d.EqualsQualString("resource_type"); resourceType != "" {
+		filter = fmt.Sprintf("security_center_properties.resource_type=\"%s\"", resourceType)
+	}
+
+	streamAssets := func(page *securitycenter.ListAssetsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, result := range page.ListAssetsResults {
+			d.StreamListItem(ctx, result)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// List the assets of the organization if the organization ID is given,
+	// else the assets of the project
+	if organizationId := d.EqualsQualString("organization_id"); organizationId != "" {
+		resp := service.Organizations.Assets.List("organizations/" + organizationId).Filter(filter).PageSize(*pageSize)
+		if err := resp.Pages(ctx, streamAssets); err != nil {
+			plugin.Logger(ctx).Error("gcp_security_command_center_asset.listSecurityCommandCenterAssets", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Assets.List("projects/" + project).Filter(filter).PageSize(*pageSize)
+	if err := resp.Pages(ctx, streamAssets); err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_asset.listSecurityCommandCenterAssets", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,291 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/securitycenter/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSecurityCommandCenterFinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_security_command_center_finding",
+		Description: "GCP Security Command Center Finding",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCommandCenterFindings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+				{Name: "source_id", Require: plugin.Optional},
+				{Name: "category", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "resource_name", Require: plugin.Optional},
+				{Name: "event_time", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<="}},
+			},
+			Tags: map[string]string{"service": "securitycenter", "action": "findings.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The relative resource name of the finding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Name"),
+			},
+			{
+				Name:        "source_id",
+				Description: "The ID of the source that produced the finding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "category",
+				Description: "The additional taxonomy group within findings from a given source, for example XSS_SCRIPTING or PUBLIC_BUCKET_ACL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Category"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the finding (CRITICAL, HIGH, MEDIUM or LOW).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Severity"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the finding (ACTIVE or INACTIVE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.State"),
+			},
+			{
+				Name:        "finding_class",
+				Description: "The class of the finding, for example THREAT, VULNERABILITY or MISCONFIGURATION.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.FindingClass"),
+			},
+			{
+				Name:        "mute",
+				Description: "Indicates the mute state of the finding (MUTED, UNMUTED or UNDEFINED).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Mute"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The full resource name of the Google Cloud resource the finding is for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.ResourceName"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The full resource type of the resource the finding is for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Type"),
+			},
+			{
+				Name:        "resource_display_name",
+				Description: "The human readable name of the resource the finding is for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.DisplayName"),
+			},
+			{
+				Name:        "resource_project_display_name",
+				Description: "The project ID that the resource the finding is for belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.ProjectDisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "Contains more details about the finding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Description"),
+			},
+			{
+				Name:        "next_steps",
+				Description: "Steps to address the finding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.NextSteps"),
+			},
+			{
+				Name:        "external_uri",
+				Description: "The URI that, if available, points to a web page outside of Security Command Center where additional information about the finding can be found.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.ExternalUri"),
+			},
+			{
+				Name:        "canonical_name",
+				Description: "The canonical name of the finding, in the form projects/{project_number}/sources/{source_id}/findings/{finding_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.CanonicalName"),
+			},
+			{
+				Name:        "event_time",
+				Description: "The time the finding was first detected, or the time the finding was last updated for findings that are updated on each detection.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Finding.EventTime").NullIfZero(),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the finding was created in Security Command Center.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Finding.CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "source_properties",
+				Description: "Source specific properties of the finding.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.SourceProperties"),
+			},
+			{
+				Name:        "security_marks",
+				Description: "User specified security marks of the finding.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.SecurityMarks.Marks"),
+			},
+			{
+				Name:        "vulnerability",
+				Description: "Details of the vulnerability the finding represents, such as its CVE.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Vulnerability"),
+			},
+			{
+				Name:        "compliances",
+				Description: "The compliance standards, such as CIS or PCI, associated with the finding.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Compliances"),
+			},
+			{
+				Name:        "resource",
+				Description: "Information related to the Google Cloud resource the finding is for.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization the findings are listed from. Only set when the organization_id is given in the query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Finding.Category"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Finding.Name").TransformP(locationResourceAkas, "securitycenter.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCommandCenterFindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SecurityCenterService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_finding.listSecurityCommandCenterFindings", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Wildcard to list the findings of all the sources at once
+	sourceId := d.EqualsQualString("source_id")
+	if sourceId == "" {
+		sourceId = "-"
+	}
+
+	filter := buildSecurityCommandCenterFindingFilter(d.Quals)
+
+	streamFindings := func(page *securitycenter.ListFindingsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, result := range page.ListFindingsResults {
+			d.StreamListItem(ctx, result)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// List the findings of the organization if the organization ID is given,
+	// else the findings of the project
+	if organizationId := d.EqualsQualString("organization_id"); organizationId != "" {
+		resp := service.Organizations.Sources.Findings.List("organizations/" + organizationId + "/sources/" + sourceId).Filter(filter).PageSize(*pageSize)
+		if err := resp.Pages(ctx, streamFindings); err != nil {
+			plugin.Logger(ctx).Error("gcp_security_command_center_finding.listSecurityCommandCenterFindings", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Sources.Findings.List("projects/" + project + "/sources/" + sourceId).Filter(filter).PageSize(*pageSize)
+	if err := resp.Pages(ctx, streamFindings); err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_finding.listSecurityCommandCenterFindings", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// https://cloud.google.com/security-command-center/docs/reference/rest/v1/organizations.sources.findings/list
+func buildSecurityCommandCenterFindingFilter(quals plugin.KeyColumnQualMap) string {
+	filterQuals := []filterQualMap{
+		{"category", "category", "string"},
+		{"severity", "severity", "string"},
+		{"state", "state", "string"},
+		{"resource_name", "resource_name", "string"},
+		{"event_time", "event_time", "timestamp"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, quals)
+
+	return strings.Join(filters, " AND ")
+}
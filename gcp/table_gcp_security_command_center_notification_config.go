@@ -0,0 +1,161 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/securitycenter/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSecurityCommandCenterNotificationConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_security_command_center_notification_config",
+		Description: "GCP Security Command Center Notification Config",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCommandCenterNotificationConfigs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "securitycenter", "action": "notificationConfigs.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the notification config.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the notification config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pubsub_topic",
+				Description: "The Pub/Sub topic to send notifications to, in the form projects/{project_id}/topics/{topic}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_account",
+				Description: "The service account that needs pubsub.topics.publish permission to publish to the Pub/Sub topic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "The expression that defines the findings sent to the Pub/Sub topic. An empty filter sends all the findings.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StreamingConfig.Filter"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The relative resource name of the notification config.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization the notification configs are listed from. Only set when the organization_id is given in the query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "securitycenter.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCommandCenterNotificationConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SecurityCenterService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_notification_config.listSecurityCommandCenterNotificationConfigs", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	streamNotificationConfigs := func(page *securitycenter.ListNotificationConfigsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, config := range page.NotificationConfigs {
+			d.StreamListItem(ctx, config)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// List the notification configs of the organization if the organization ID is given,
+	// else the notification configs of the project
+	if organizationId := d.EqualsQualString("organization_id"); organizationId != "" {
+		resp := service.Organizations.NotificationConfigs.List("organizations/" + organizationId).PageSize(*pageSize)
+		if err := resp.Pages(ctx, streamNotificationConfigs); err != nil {
+			plugin.Logger(ctx).Error("gcp_security_command_center_notification_config.listSecurityCommandCenterNotificationConfigs", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.NotificationConfigs.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, streamNotificationConfigs); err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_notification_config.listSecurityCommandCenterNotificationConfigs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
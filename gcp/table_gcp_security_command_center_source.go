@@ -0,0 +1,154 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/securitycenter/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSecurityCommandCenterSource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_security_command_center_source",
+		Description: "GCP Security Command Center Source",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCommandCenterSources,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "organization_id", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "securitycenter", "action": "sources.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The relative resource name of the source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_id",
+				Description: "The ID of the source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The source's display name, for example Web Security Scanner or Security Health Analytics.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "canonical_name",
+				Description: "The canonical name of the source, in the form projects/{project_number}/sources/{source_id} for project-level queries.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization the sources are listed from. Only set when the organization_id is given in the query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("organization_id"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "securitycenter.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCommandCenterSources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SecurityCenterService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_source.listSecurityCommandCenterSources", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	streamSources := func(page *securitycenter.ListSourcesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, source := range page.Sources {
+			d.StreamListItem(ctx, source)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// List the sources of the organization if the organization ID is given,
+	// else the sources of the project
+	if organizationId := d.EqualsQualString("organization_id"); organizationId != "" {
+		resp := service.Organizations.Sources.List("organizations/" + organizationId).PageSize(*pageSize)
+		if err := resp.Pages(ctx, streamSources); err != nil {
+			plugin.Logger(ctx).Error("gcp_security_command_center_source.listSecurityCommandCenterSources", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Sources.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, streamSources); err != nil {
+		plugin.Logger(ctx).Error("gcp_security_command_center_source.listSecurityCommandCenterSources", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
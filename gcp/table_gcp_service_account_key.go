@@ -25,7 +25,10 @@ func tableGcpServiceAccountKey(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listGcpServiceAccounts,
 			Hydrate:       listGcpServiceAccountKeys,
-			Tags:          map[string]string{"service": "iam", "action": "serviceAccountKeys.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "key_type", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "iam", "action": "serviceAccountKeys.list"},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
@@ -65,6 +68,16 @@ func tableGcpServiceAccountKey(_ context.Context) *plugin.Table {
 				Description: "Specifies the origin of the key.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "disabled",
+				Description: "True if the key is disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "disable_reason",
+				Description: "The reason the key was disabled, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "public_key_data_pem",
 				Description: "Specifies the public key data in PEM format.",
@@ -89,6 +102,11 @@ func tableGcpServiceAccountKey(_ context.Context) *plugin.Table {
 				Description: "Specifies the timestamp, after which the key gets invalid.",
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "extended_status",
+				Description: "Extended status provides additional information about the key, such as whether it has been exposed or is disabled by an organization policy.",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// standard steampipe columns
 			{
@@ -134,7 +152,12 @@ func listGcpServiceAccountKeys(ctx context.Context, d *plugin.QueryData, h *plug
 		return nil, err
 	}
 
-	result, err := service.Projects.ServiceAccounts.Keys.List(serviceAccount.Name).Do()
+	resp := service.Projects.ServiceAccounts.Keys.List(serviceAccount.Name)
+	if keyType := d.EqualsQualString("key_type"); keyType != "" {
+		resp = resp.KeyTypes(keyType)
+	}
+
+	result, err := resp.Do()
 	// apply rate limiting
 	d.WaitForListRateLimit(ctx)
 
@@ -0,0 +1,248 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/servicedirectory/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpServiceDirectoryEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_service_directory_endpoint",
+		Description: "GCP Service Directory Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "service_name", "namespace_name", "location"}),
+			Hydrate:    getServiceDirectoryEndpoint,
+			Tags:       map[string]string{"service": "servicedirectory", "action": "endpoints.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listServiceDirectoryNamespaces,
+			Hydrate:       listServiceDirectoryEndpoints,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "namespace_name", Require: plugin.Optional},
+				{Name: "service_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "servicedirectory", "action": "endpoints.list"},
+		},
+		GetMatrixItemFunc: BuildServiceDirectoryLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the service the endpoint belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 7),
+			},
+			{
+				Name:        "namespace_name",
+				Description: "The name of the namespace the endpoint belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "uid",
+				Description: "A globally unique identifier (in UUID4 format) for the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "address",
+				Description: "An IPv4 or IPv6 address of the endpoint.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Address").NullIfZero(),
+			},
+			{
+				Name:        "port",
+				Description: "Service Directory rejects values outside of [0, 65535].",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "network",
+				Description: "The Google Compute Engine network (VPC) of the endpoint, in the format projects/{project_number}/locations/global/networks/{network}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network").NullIfZero(),
+			},
+			{
+				Name:        "network_name",
+				Description: "The name of the Google Compute Engine network (VPC) of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network").Transform(lastPathElement),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations for the endpoint. This data can be consumed by service clients.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "servicedirectory.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceDirectoryEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	namespace := h.Item.(*servicedirectory.Namespace)
+
+	// Minimize the API calls with the given namespace name
+	namespaceName := d.EqualsQualString("namespace_name")
+	if namespaceName != "" && namespaceName != getLastPathElement(namespace.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ServiceDirectoryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_endpoint.listServiceDirectoryEndpoints", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// The endpoints are listed per service, so the services of the namespace are listed first
+	var serviceNames []string
+	serviceName := d.EqualsQualString("service_name")
+	if serviceName != "" {
+		serviceNames = append(serviceNames, namespace.Name+"/services/"+serviceName)
+	} else {
+		resp := service.Projects.Locations.Namespaces.Services.List(namespace.Name).PageSize(1000)
+		if err := resp.Pages(ctx, func(page *servicedirectory.ListServicesResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, item := range page.Services {
+				serviceNames = append(serviceNames, item.Name)
+			}
+			return nil
+		}); err != nil {
+			plugin.Logger(ctx).Error("gcp_service_directory_endpoint.listServiceDirectoryEndpoints", "api_error", err)
+			return nil, err
+		}
+	}
+
+	for _, name := range serviceNames {
+		resp := service.Projects.Locations.Namespaces.Services.Endpoints.List(name).PageSize(*pageSize)
+		if err := resp.Pages(ctx, func(page *servicedirectory.ListEndpointsResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, endpoint := range page.Endpoints {
+				d.StreamListItem(ctx, endpoint)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			// The service given in the qual may not exist in this namespace
+			if serviceName != "" && isIgnorableError([]string{"404"})(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("gcp_service_directory_endpoint.listServiceDirectoryEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getServiceDirectoryEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	namespaceName := d.EqualsQualString("namespace_name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || serviceName == "" || namespaceName == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ServiceDirectoryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_endpoint.getServiceDirectoryEndpoint", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Namespaces.Services.Endpoints.Get("projects/" + project + "/locations/" + location + "/namespaces/" + namespaceName + "/services/" + serviceName + "/endpoints/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_endpoint.getServiceDirectoryEndpoint", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,186 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/servicedirectory/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpServiceDirectoryService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_service_directory_service",
+		Description: "GCP Service Directory Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace_name", "location"}),
+			Hydrate:    getServiceDirectoryService,
+			Tags:       map[string]string{"service": "servicedirectory", "action": "services.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listServiceDirectoryNamespaces,
+			Hydrate:       listServiceDirectoryServices,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "namespace_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "servicedirectory", "action": "services.list"},
+		},
+		GetMatrixItemFunc: BuildServiceDirectoryLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "namespace_name",
+				Description: "The name of the namespace the service belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "uid",
+				Description: "A globally unique identifier (in UUID4 format) for the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations for the service. This data can be consumed by service clients.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "servicedirectory.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceDirectoryServices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	namespace := h.Item.(*servicedirectory.Namespace)
+
+	// Minimize the API call with the given namespace name
+	namespaceName := d.EqualsQualString("namespace_name")
+	if namespaceName != "" && namespaceName != getLastPathElement(namespace.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ServiceDirectoryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_service.listServiceDirectoryServices", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Locations.Namespaces.Services.List(namespace.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *servicedirectory.ListServicesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, item := range page.Services {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_service.listServiceDirectoryServices", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getServiceDirectoryService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	namespaceName := d.EqualsQualString("namespace_name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || namespaceName == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ServiceDirectoryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_service.getServiceDirectoryService", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Namespaces.Services.Get("projects/" + project + "/locations/" + location + "/namespaces/" + namespaceName + "/services/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_directory_service.getServiceDirectoryService", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
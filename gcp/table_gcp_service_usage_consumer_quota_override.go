@@ -0,0 +1,221 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	serviceusageBeta "google.golang.org/api/serviceusage/v1beta1"
+)
+
+type serviceUsageConsumerQuotaOverride struct {
+	ServiceName  string
+	OverrideType string
+	Metric       *serviceusageBeta.ConsumerQuotaMetric
+	Bucket       *serviceusageBeta.QuotaBucket
+	Override     *serviceusageBeta.QuotaOverride
+}
+
+//// TABLE DEFINITION
+
+func tableGcpServiceUsageConsumerQuotaOverride(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_service_usage_consumer_quota_override",
+		Description: "GCP Service Usage Consumer Quota Override",
+		List: &plugin.ListConfig{
+			Hydrate: listServiceUsageConsumerQuotaOverrides,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service_name", Require: plugin.Optional},
+				{Name: "metric", Require: plugin.Optional},
+				{Name: "override_type", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "serviceusage", "action": "services.consumerQuotaMetrics.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the quota override.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "override_type",
+				Description: "The type of the quota override, CONSUMER for an override set on the project, or ADMIN for an override set by an administrator on the organization or the folder of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the service the quota belongs to, for example compute.googleapis.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric",
+				Description: "The name of the quota metric, for example compute.googleapis.com/cpus.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.Metric"),
+			},
+			{
+				Name:        "metric_display_name",
+				Description: "The human readable name of the quota metric, for example CPUs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metric.DisplayName"),
+			},
+			{
+				Name:        "limit_unit",
+				Description: "The unit of the overridden quota limit, for example 1/{project}/{region}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.Unit"),
+			},
+			{
+				Name:        "override_value",
+				Description: "The value of the quota limit set by the override. -1 means unlimited.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Override.OverrideValue"),
+			},
+			{
+				Name:        "default_limit",
+				Description: "The default value of the quota limit, before any override is applied. -1 means unlimited.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.DefaultLimit"),
+			},
+			{
+				Name:        "effective_limit",
+				Description: "The value of the quota limit in effect, once all the overrides are applied. -1 means unlimited.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.EffectiveLimit"),
+			},
+			{
+				Name:        "admin_override_ancestor",
+				Description: "The organization or folder the admin override was set on, in the form organizations/{organization} or folders/{folder}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.AdminOverrideAncestor").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the quota override.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.Name"),
+			},
+			{
+				Name:        "dimensions",
+				Description: "The dimensions the quota override applies to, for example the region of a regional quota. Empty if it applies to all the locations.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Override.Dimensions"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Override.Name").TransformP(locationResourceAkas, "serviceusage.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Override.Dimensions").Transform(serviceUsageQuotaLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceUsageConsumerQuotaOverrides(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ServiceUsageBetaService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_usage_consumer_quota_override.listServiceUsageConsumerQuotaOverrides", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	serviceNames, err := listServiceUsageQuotaServiceNames(ctx, d, project)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_usage_consumer_quota_override.listServiceUsageConsumerQuotaOverrides", "api_error", err)
+		return nil, err
+	}
+
+	metricName := d.EqualsQualString("metric")
+	overrideType := d.EqualsQualString("override_type")
+
+	for _, serviceName := range serviceNames {
+		// The BASIC view only returns the buckets that have an override
+		resp := service.Services.ConsumerQuotaMetrics.List("projects/" + project + "/services/" + serviceName).View("BASIC")
+		if err := resp.Pages(ctx, func(page *serviceusageBeta.ListConsumerQuotaMetricsResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, metric := range page.Metrics {
+				if metricName != "" && metricName != metric.Metric {
+					continue
+				}
+				for _, limit := range metric.ConsumerQuotaLimits {
+					for _, bucket := range limit.QuotaBuckets {
+						overrides := map[string]*serviceusageBeta.QuotaOverride{
+							"CONSUMER": bucket.ConsumerOverride,
+							"ADMIN":    bucket.AdminOverride,
+						}
+						for _, t := range []string{"CONSUMER", "ADMIN"} {
+							if overrides[t] == nil || (overrideType != "" && overrideType != t) {
+								continue
+							}
+							d.StreamListItem(ctx, &serviceUsageConsumerQuotaOverride{
+								ServiceName:  serviceName,
+								OverrideType: t,
+								Metric:       metric,
+								Bucket:       bucket,
+								Override:     overrides[t],
+							})
+
+							// Check if context has been cancelled or if the limit has been hit (if specified)
+							// if there is a limit, it will return the number of rows required to reach this limit
+							if d.RowsRemaining(ctx) == 0 {
+								page.NextPageToken = ""
+								return nil
+							}
+						}
+					}
+				}
+			}
+			return nil
+		}); err != nil {
+			// The service does not define any quota for the consumers
+			if isIgnorableError([]string{"404"})(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("gcp_service_usage_consumer_quota_override.listServiceUsageConsumerQuotaOverrides", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
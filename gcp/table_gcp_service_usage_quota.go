@@ -0,0 +1,376 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/serviceusage/v1"
+	serviceusageBeta "google.golang.org/api/serviceusage/v1beta1"
+)
+
+// A quota limit is split in buckets, one per combination of its dimensions,
+// for example one bucket per region for a regional quota
+type serviceUsageQuotaBucket struct {
+	ServiceName string
+	Metric      *serviceusageBeta.ConsumerQuotaMetric
+	Limit       *serviceusageBeta.ConsumerQuotaLimit
+	Bucket      *serviceusageBeta.QuotaBucket
+}
+
+//// TABLE DEFINITION
+
+func tableGcpServiceUsageQuota(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_service_usage_quota",
+		Description: "GCP Service Usage Quota",
+		List: &plugin.ListConfig{
+			Hydrate: listServiceUsageQuotas,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service_name", Require: plugin.Optional},
+				{Name: "metric", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "serviceusage", "action": "services.consumerQuotaMetrics.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getServiceUsageQuotaUsage,
+				Tags: map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Description: "The name of the service the quota belongs to, for example compute.googleapis.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric",
+				Description: "The name of the quota metric, for example compute.googleapis.com/cpus.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metric.Metric"),
+			},
+			{
+				Name:        "metric_display_name",
+				Description: "The human readable name of the quota metric, for example CPUs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metric.DisplayName"),
+			},
+			{
+				Name:        "limit_unit",
+				Description: "The unit of the quota limit, which identifies the limit within the metric, for example 1/{project}/{region} for an allocation quota, or 1/min/{project} for a rate quota.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Limit.Unit"),
+			},
+			{
+				Name:        "default_limit",
+				Description: "The default value of the quota limit, before any override is applied. -1 means unlimited.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.DefaultLimit"),
+			},
+			{
+				Name:        "effective_limit",
+				Description: "The value of the quota limit in effect, once the overrides are applied. -1 means unlimited.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.EffectiveLimit"),
+			},
+			{
+				Name:        "current_usage",
+				Description: "The latest usage of the quota over the last day, as reported by Cloud Monitoring. Only available for allocation quotas.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getServiceUsageQuotaUsage,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "consumer_override_value",
+				Description: "The value of the quota override set on the project, if any.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.ConsumerOverride.OverrideValue"),
+			},
+			{
+				Name:        "admin_override_value",
+				Description: "The value of the quota override set by an administrator on the organization or the folder of the project, if any.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.AdminOverride.OverrideValue"),
+			},
+			{
+				Name:        "producer_override_value",
+				Description: "The value of the quota override set by the service producer, for example after a quota increase request, if any.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bucket.ProducerOverride.OverrideValue"),
+			},
+			{
+				Name:        "is_precise",
+				Description: "Specifies whether the quota limit is enforced precisely.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Limit.IsPrecise"),
+			},
+			{
+				Name:        "allows_admin_overrides",
+				Description: "Specifies whether administrators can override the quota limit.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Limit.AllowsAdminOverrides"),
+			},
+			{
+				Name:        "limit_name",
+				Description: "The resource name of the quota limit.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Limit.Name"),
+			},
+			{
+				Name:        "dimensions",
+				Description: "The dimensions of the quota bucket, for example the region of a regional quota. Empty for the bucket that applies to all the locations.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Bucket.Dimensions"),
+			},
+			{
+				Name:        "consumer_override",
+				Description: "The quota override set on the project, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Bucket.ConsumerOverride"),
+			},
+			{
+				Name:        "admin_override",
+				Description: "The quota override set by an administrator on the organization or the folder of the project, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Bucket.AdminOverride"),
+			},
+			{
+				Name:        "producer_override",
+				Description: "The quota override set by the service producer, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Bucket.ProducerOverride"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metric.DisplayName"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Bucket.Dimensions").Transform(serviceUsageQuotaLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceUsageQuotas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ServiceUsageBetaService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_usage_quota.listServiceUsageQuotas", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	serviceNames, err := listServiceUsageQuotaServiceNames(ctx, d, project)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_usage_quota.listServiceUsageQuotas", "api_error", err)
+		return nil, err
+	}
+
+	metricName := d.EqualsQualString("metric")
+
+	for _, serviceName := range serviceNames {
+		// The FULL view returns a bucket per region or zone of the regional and zonal
+		// quotas, even if they have no override
+		resp := service.Services.ConsumerQuotaMetrics.List("projects/" + project + "/services/" + serviceName).View("FULL")
+		if err := resp.Pages(ctx, func(page *serviceusageBeta.ListConsumerQuotaMetricsResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, metric := range page.Metrics {
+				if metricName != "" && metricName != metric.Metric {
+					continue
+				}
+				for _, limit := range metric.ConsumerQuotaLimits {
+					for _, bucket := range limit.QuotaBuckets {
+						d.StreamListItem(ctx, &serviceUsageQuotaBucket{
+							ServiceName: serviceName,
+							Metric:      metric,
+							Limit:       limit,
+							Bucket:      bucket,
+						})
+
+						// Check if context has been cancelled or if the limit has been hit (if specified)
+						// if there is a limit, it will return the number of rows required to reach this limit
+						if d.RowsRemaining(ctx) == 0 {
+							page.NextPageToken = ""
+							return nil
+						}
+					}
+				}
+			}
+			return nil
+		}); err != nil {
+			// The service does not define any quota for the consumers
+			if isIgnorableError([]string{"404"})(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("gcp_service_usage_quota.listServiceUsageQuotas", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getServiceUsageQuotaUsage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quota := h.Item.(*serviceUsageQuotaBucket)
+
+	usage, err := getServiceUsageQuotaAllocationUsageMemoized(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_usage_quota.getServiceUsageQuotaUsage", "api_error", err)
+		return nil, err
+	}
+
+	location := serviceUsageQuotaBucketLocation(quota.Bucket.Dimensions)
+	if value, ok := usage.(map[string]int64)[quota.Metric.Metric+"/"+location]; ok {
+		return value, nil
+	}
+
+	return nil, nil
+}
+
+// The allocation usage is fetched once per service, for all its quota metrics and locations
+var getServiceUsageQuotaAllocationUsageMemoized = plugin.HydrateFunc(getServiceUsageQuotaAllocationUsageUncached).Memoize(memoize.WithCacheKeyFunction(getServiceUsageQuotaAllocationUsageCacheKey))
+
+// Build a cache key for the call to getServiceUsageQuotaAllocationUsage.
+func getServiceUsageQuotaAllocationUsageCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quota := h.Item.(*serviceUsageQuotaBucket)
+	key := fmt.Sprintf("getServiceUsageQuotaAllocationUsage%s", quota.ServiceName)
+	return key, nil
+}
+
+// getServiceUsageQuotaAllocationUsageUncached returns the latest allocation
+// usage of the quotas of a service, keyed by {metric}/{location}
+func getServiceUsageQuotaAllocationUsageUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quota := h.Item.(*serviceUsageQuotaBucket)
+	usage := map[string]int64{}
+
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	filter := fmt.Sprintf("metric.type = \"serviceruntime.googleapis.com/quota/allocation/usage\" AND resource.type = \"consumer_quota\" AND resource.labels.service = \"%s\"", quota.ServiceName)
+	endTime := time.Now()
+	startTime := endTime.Add(-24 * time.Hour)
+
+	// Align each time series on a single point, holding its latest value
+	resp := service.Projects.TimeSeries.List("projects/" + project).Filter(filter).IntervalStartTime(startTime.Format(time.RFC3339)).IntervalEndTime(endTime.Format(time.RFC3339)).AggregationAlignmentPeriod("86400s").AggregationPerSeriesAligner("ALIGN_NEXT_OLDER")
+	if err := resp.Pages(ctx, func(page *monitoring.ListTimeSeriesResponse) error {
+		for _, timeSeries := range page.TimeSeries {
+			if len(timeSeries.Points) == 0 || timeSeries.Points[0].Value == nil || timeSeries.Points[0].Value.Int64Value == nil {
+				continue
+			}
+			key := timeSeries.Metric.Labels["quota_metric"] + "/" + timeSeries.Resource.Labels["location"]
+
+			// The points are returned from the newest to the oldest
+			value := *timeSeries.Points[0].Value.Int64Value
+			if current, ok := usage[key]; !ok || value > current {
+				usage[key] = value
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serviceUsageQuotaLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dimensions, _ := d.Value.(map[string]string)
+	return serviceUsageQuotaBucketLocation(dimensions), nil
+}
+
+//// UTILITY FUNCTIONS
+
+// serviceUsageQuotaBucketLocation returns the region or the zone of a quota
+// bucket, or global for the bucket that applies to all the locations
+func serviceUsageQuotaBucketLocation(dimensions map[string]string) string {
+	if region, ok := dimensions["region"]; ok {
+		return region
+	}
+	if zone, ok := dimensions["zone"]; ok {
+		return zone
+	}
+	return "global"
+}
+
+// listServiceUsageQuotaServiceNames returns the service given in the
+// service_name qual, or all the services enabled in the project
+func listServiceUsageQuotaServiceNames(ctx context.Context, d *plugin.QueryData, project string) ([]string, error) {
+	if serviceName := d.EqualsQualString("service_name"); serviceName != "" {
+		return []string{serviceName}, nil
+	}
+
+	// Create Service Connection
+	service, err := ServiceUsageService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.48.0/serviceusage/v1?utm_source=gopls#ServicesListCall.PageSize
+	pageSize := types.Int64(200)
+
+	var serviceNames []string
+	resp := service.Services.List("projects/" + project).Filter("state:ENABLED").PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *serviceusage.ListServicesResponse) error {
+		for _, service := range page.Services {
+			serviceNames = append(serviceNames, getLastPathElement(service.Name))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return serviceNames, nil
+}
@@ -0,0 +1,227 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/sourcerepo/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSourceRepositoriesRepo(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_source_repositories_repo",
+		Description: "GCP Cloud Source Repositories Repo",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getSourceRepositoriesRepo,
+			Tags:       map[string]string{"service": "sourcerepo", "action": "repos.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSourceRepositoriesRepos,
+			Tags:    map[string]string{"service": "sourcerepo", "action": "repos.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSourceRepositoriesRepoIamPolicy,
+				Tags: map[string]string{"service": "sourcerepo", "action": "repos.getIamPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the repository. Repository names can contain slashes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(sourceRepositoriesRepoName),
+			},
+			{
+				Name:        "url",
+				Description: "URL to clone the repository from Google Cloud Source Repositories.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "size",
+				Description: "The disk usage of the repository, in bytes. Read-only field.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "mirror_url",
+				Description: "URL of the repository the repo is mirrored from, if it is a mirror of a GitHub or Bitbucket repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MirrorConfig.Url"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The resource name of the repository, in the form projects/{project}/repos/{repo}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "mirror_config",
+				Description: "How the repository mirrors a repository managed by another service.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pubsub_configs",
+				Description: "How the repository publishes a change in the repository through Cloud Pub/Sub, keyed by the topic names.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the repository.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSourceRepositoriesRepoIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(sourceRepositoriesRepoName),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "sourcerepo.googleapis.com"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSourceRepositoriesRepos(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SourceRepoService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_source_repositories_repo.listSourceRepositoriesRepos", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Repos.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *sourcerepo.ListReposResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, repo := range page.Repos {
+			d.StreamListItem(ctx, repo)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_source_repositories_repo.listSourceRepositoriesRepos", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSourceRepositoriesRepo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SourceRepoService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_source_repositories_repo.getSourceRepositoriesRepo", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Repos.Get("projects/" + project + "/repos/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_source_repositories_repo.getSourceRepositoriesRepo", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getSourceRepositoriesRepoIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(*sourcerepo.Repo)
+
+	// Create Service Connection
+	service, err := SourceRepoService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_source_repositories_repo.getSourceRepositoriesRepoIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Repos.GetIamPolicy(repo.Name).OptionsRequestedPolicyVersion(3).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_source_repositories_repo.getSourceRepositoriesRepoIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The resource name has the form projects/{project}/repos/{repo}, where the
+// repo name itself can contain slashes
+func sourceRepositoriesRepoName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.SplitN(d.Value.(string), "/", 4)
+	if len(parts) < 4 {
+		return nil, nil
+	}
+	return parts[3], nil
+}
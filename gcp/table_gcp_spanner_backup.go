@@ -0,0 +1,231 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/spanner/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSpannerBackup(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_spanner_backup",
+		Description: "GCP Spanner Backup",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"instance_name", "name"}),
+			Hydrate:    getSpannerBackup,
+			Tags:       map[string]string{"service": "spanner", "action": "backups.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSpannerInstances,
+			Hydrate:       listSpannerBackups,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "instance_name",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "spanner", "action": "backups.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSpannerParentInstanceLocation,
+				Tags: map[string]string{"service": "spanner", "action": "instances.get"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the backup.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance the backup belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(spannerResourceInstanceName),
+			},
+			{
+				Name:        "database",
+				Description: "The name of the database from which this backup was created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Database").Transform(lastPathElement),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the backup (CREATING or READY).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database_dialect",
+				Description: "The database dialect information for the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the CreateBackup request is received.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "version_time",
+				Description: "The backup contains an externally consistent copy of the database at this time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("VersionTime").NullIfZero(),
+			},
+			{
+				Name:        "expire_time",
+				Description: "The expiration time of the backup.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireTime").NullIfZero(),
+			},
+			{
+				Name:        "size_bytes",
+				Description: "Size of the backup in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "encryption_type",
+				Description: "The type of encryption of the backup (GOOGLE_DEFAULT_ENCRYPTION or CUSTOMER_MANAGED_ENCRYPTION).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionInfo.EncryptionType"),
+			},
+			{
+				Name:        "encryption_info",
+				Description: "The encryption information for the backup.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "backup_schedules",
+				Description: "The names of the backup schedules that created this backup, if any.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "referencing_databases",
+				Description: "The names of the restored databases that reference the backup.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(spannerResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSpannerParentInstanceLocation,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(spannerResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSpannerBackups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*spanner.Instance)
+
+	// Minimize API call as per given instance
+	instanceName := d.EqualsQualString("instance_name")
+	if instanceName != "" && instanceName != getLastPathElement(instance.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_backup.listSpannerBackups", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Instances.Backups.List(instance.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *spanner.ListBackupsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, backup := range page.Backups {
+			d.StreamListItem(ctx, backup)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_backup.listSpannerBackups", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSpannerBackup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceName := d.EqualsQualString("instance_name")
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if instanceName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_backup.getSpannerBackup", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Instances.Backups.Get("projects/" + project + "/instances/" + instanceName + "/backups/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_backup.getSpannerBackup", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
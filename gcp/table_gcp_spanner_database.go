@@ -0,0 +1,325 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/spanner/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSpannerDatabase(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_spanner_database",
+		Description: "GCP Spanner Database",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"instance_name", "name"}),
+			Hydrate:    getSpannerDatabase,
+			Tags:       map[string]string{"service": "spanner", "action": "databases.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSpannerInstances,
+			Hydrate:       listSpannerDatabases,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "instance_name",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "spanner", "action": "databases.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSpannerDatabaseDdl,
+				Tags: map[string]string{"service": "spanner", "action": "databases.getDdl"},
+			},
+			{
+				Func: getSpannerParentInstanceLocation,
+				Tags: map[string]string{"service": "spanner", "action": "instances.get"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance the database belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(spannerResourceInstanceName),
+			},
+			{
+				Name:        "state",
+				Description: "The current database state (CREATING, READY or READY_OPTIMIZING).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database_dialect",
+				Description: "The dialect of the Cloud Spanner Database (GOOGLE_STANDARD_SQL or POSTGRESQL).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "If exists, the time at which the database creation started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "default_leader",
+				Description: "The read-write region which contains the database's leader replicas.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_drop_protection",
+				Description: "Whether drop protection is enabled for this database.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "reconciling",
+				Description: "If true, the database is being updated.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "version_retention_period",
+				Description: "The period in which Cloud Spanner retains all versions of data for the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "earliest_version_time",
+				Description: "Earliest timestamp at which older versions of the data can be read.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EarliestVersionTime").NullIfZero(),
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS key used to encrypt and decrypt the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionConfig.KmsKeyName"),
+			},
+			{
+				Name:        "encryption_config",
+				Description: "For databases that are using customer managed encryption, this field contains the encryption configuration for the database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "encryption_info",
+				Description: "For databases that are using customer managed encryption, this field contains the encryption information for the database, such as all Cloud KMS key versions that are in use.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "restore_info",
+				Description: "Information about the source used to restore the database, if any.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ddl_statements",
+				Description: "The DDL statements that define the database schema.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSpannerDatabaseDdl,
+				Transform:   transform.FromField("Statements"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(spannerResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSpannerParentInstanceLocation,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(spannerResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSpannerDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*spanner.Instance)
+
+	// Minimize API call as per given instance
+	instanceName := d.EqualsQualString("instance_name")
+	if instanceName != "" && instanceName != getLastPathElement(instance.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_database.listSpannerDatabases", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Projects.Instances.Databases.List(instance.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *spanner.ListDatabasesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, database := range page.Databases {
+			d.StreamListItem(ctx, database)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_database.listSpannerDatabases", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSpannerDatabase(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceName := d.EqualsQualString("instance_name")
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if instanceName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_database.getSpannerDatabase", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Instances.Databases.Get("projects/" + project + "/instances/" + instanceName + "/databases/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_database.getSpannerDatabase", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getSpannerDatabaseDdl(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	database := h.Item.(*spanner.Database)
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_database.getSpannerDatabaseDdl", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Instances.Databases.GetDdl(database.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_database.getSpannerDatabaseDdl", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Databases and backups have no location of their own, it is taken from the
+// configuration of their instance.
+func getSpannerParentInstanceLocation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance, err := getSpannerParentInstanceMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return spannerLocationFromConfig(instance.(*spanner.Instance).Config), nil
+}
+
+var getSpannerParentInstanceMemoized = plugin.HydrateFunc(getSpannerParentInstanceUncached).Memoize(memoize.WithCacheKeyFunction(getSpannerParentInstanceCacheKey))
+
+func getSpannerParentInstanceCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return "getSpannerParentInstance" + spannerParentInstance(h.Item), nil
+}
+
+func getSpannerParentInstanceUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.Projects.Instances.Get(spannerParentInstance(h.Item)).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// spannerParentInstance returns the full name of the instance of a database or backup
+func spannerParentInstance(item interface{}) string {
+	var name string
+	switch item := item.(type) {
+	case *spanner.Database:
+		name = item.Name
+	case *spanner.Backup:
+		name = item.Name
+	}
+
+	parts := strings.Split(name, "/")
+	if len(parts) < 4 {
+		return ""
+	}
+	return strings.Join(parts[:4], "/")
+}
+
+//// TRANSFORM FUNCTIONS
+
+func spannerResourceInstanceName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 4 {
+		return nil, nil
+	}
+	return parts[3], nil
+}
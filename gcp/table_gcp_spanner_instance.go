@@ -0,0 +1,289 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/spanner/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpSpannerInstance(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_spanner_instance",
+		Description: "GCP Spanner Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getSpannerInstance,
+			Tags:       map[string]string{"service": "spanner", "action": "instances.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSpannerInstances,
+			Tags:    map[string]string{"service": "spanner", "action": "instances.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSpannerInstanceIamPolicy,
+				Tags: map[string]string{"service": "spanner", "action": "instances.getIamPolicy"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A unique identifier for the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The descriptive name for this instance as it appears in UIs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current instance state (CREATING or READY).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_config",
+				Description: "The name of the instance configuration, which defines the geographic placement and replication of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config").Transform(lastPathElement),
+			},
+			{
+				Name:        "node_count",
+				Description: "The number of nodes allocated to this instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "processing_units",
+				Description: "The number of processing units allocated to this instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "edition",
+				Description: "The edition of the instance (STANDARD, ENTERPRISE or ENTERPRISE_PLUS).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The type of the instance (PROVISIONED or FREE_INSTANCE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_backup_schedule_type",
+				Description: "Controls the default backup schedule behavior for new databases within the instance (NONE or AUTOMATIC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time at which the instance was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "autoscaling_config",
+				Description: "The autoscaling configuration. Autoscaling is enabled if this field is set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "endpoint_uris",
+				Description: "Deprecated. This field is not populated.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Cloud Labels are a flexible and lightweight mechanism for organizing cloud resources into groups.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for Google Cloud resources.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSpannerInstanceIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(spannerResourceAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Config").Transform(spannerInstanceConfigLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(spannerResourceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSpannerInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_instance.listSpannerInstances", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Instances.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *spanner.ListInstancesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, instance := range page.Instances {
+			d.StreamListItem(ctx, instance)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_instance.listSpannerInstances", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSpannerInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty Check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_instance.getSpannerInstance", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Instances.Get("projects/" + project + "/instances/" + name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_instance.getSpannerInstance", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getSpannerInstanceIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*spanner.Instance)
+
+	// Create Service Connection
+	service, err := SpannerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_instance.getSpannerInstanceIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Instances.GetIamPolicy(instance.Name, &spanner.GetIamPolicyRequest{}).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_spanner_instance.getSpannerInstanceIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Spanner resource names have the form projects/{project}/instances/{instance}/...
+func spannerResourceProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	if len(parts) < 2 {
+		return nil, nil
+	}
+	return parts[1], nil
+}
+
+func spannerResourceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://spanner.googleapis.com/" + name}, nil
+}
+
+func spannerInstanceConfigLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	config := types.SafeString(d.Value)
+	if config == "" {
+		return nil, nil
+	}
+	return spannerLocationFromConfig(config), nil
+}
+
+// Regional instance configurations are named regional-{region}, multi-region
+// ones (e.g. nam3, eur6) are returned as is.
+func spannerLocationFromConfig(config string) string {
+	return strings.TrimPrefix(getLastPathElement(config), "regional-")
+}
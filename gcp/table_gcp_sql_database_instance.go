@@ -280,6 +280,54 @@ func tableGcpSQLDatabaseInstance(ctx context.Context) *plugin.Table {
 				Description: "The current software version on the instance.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "ipv4_enabled",
+				Description: "Whether the instance is assigned a public IP address or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Settings.IpConfiguration.Ipv4Enabled"),
+			},
+			{
+				Name:        "private_network",
+				Description: "The resource link for the VPC network from which the Cloud SQL instance is accessible for private IP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Settings.IpConfiguration.PrivateNetwork"),
+			},
+			{
+				Name:        "require_ssl",
+				Description: "Whether SSL/TLS connections over IP are enforced. Deprecated in favor of ssl_mode.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Settings.IpConfiguration.RequireSsl"),
+			},
+			{
+				Name:        "ssl_mode",
+				Description: "Specify how SSL/TLS is enforced in database connections (ALLOW_UNENCRYPTED_AND_ENCRYPTED, ENCRYPTED_ONLY or TRUSTED_CLIENT_CERTIFICATE_REQUIRED).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Settings.IpConfiguration.SslMode"),
+			},
+			{
+				Name:        "deletion_protection_enabled",
+				Description: "Configuration to protect against accidental instance deletion.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Settings.DeletionProtectionEnabled"),
+			},
+			{
+				Name:        "edition",
+				Description: "The edition of the instance (ENTERPRISE or ENTERPRISE_PLUS).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Settings.Edition"),
+			},
+			{
+				Name:        "connector_enforcement",
+				Description: "Specifies if connections must use Cloud SQL connectors (REQUIRED or NOT_REQUIRED).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Settings.ConnectorEnforcement"),
+			},
+			{
+				Name:        "authorized_networks",
+				Description: "The list of external networks that are allowed to connect to the instance using the IP.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Settings.IpConfiguration.AuthorizedNetworks"),
+			},
 			{
 				Name:        "authorized_gae_applications",
 				Description: "A list of App Engine app IDs, that can access this instance.",
@@ -2,6 +2,7 @@ package gcp
 
 import (
 	"context"
+	"slices"
 
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -150,6 +151,43 @@ func tableGcpStorageBucket(_ context.Context) *plugin.Table {
 				Default:     false,
 				Transform:   transform.FromField("Versioning.Enabled"),
 			},
+			{
+				Name:        "autoclass_enabled",
+				Description: "Whether or not Autoclass is enabled on this bucket. Autoclass automatically transitions objects to the storage class that best fits their access pattern.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+				Transform:   transform.FromField("Autoclass.Enabled"),
+			},
+			{
+				Name:        "autoclass_terminal_storage_class",
+				Description: "The storage class that objects in the bucket eventually transition to if they are not read for a certain length of time (NEARLINE or ARCHIVE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Autoclass.TerminalStorageClass"),
+			},
+			{
+				Name:        "soft_delete_retention_duration_seconds",
+				Description: "The period of time that soft-deleted objects in the bucket must be retained and cannot be permanently deleted. A value of 0 disables soft delete.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SoftDeletePolicy.RetentionDurationSeconds"),
+			},
+			{
+				Name:        "hierarchical_namespace_enabled",
+				Description: "When set to true, hierarchical namespace is enabled for this bucket.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+				Transform:   transform.FromField("HierarchicalNamespace.Enabled"),
+			},
+			{
+				Name:        "object_retention_mode",
+				Description: "The bucket's object retention mode. Can be Enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ObjectRetention.Mode"),
+			},
+			{
+				Name:        "rpo",
+				Description: "The Recovery Point Objective (RPO) of this bucket. Set to ASYNC_TURBO to turn on Turbo Replication on a dual-region bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "website_main_page_suffix",
 				Description: "If the requested object path is missing, the service will ensure the path has a trailing '/', append this suffix, and attempt to retrieve the resulting object. This allows the creation of index.html objects to represent directory pages.",
@@ -194,6 +232,32 @@ func tableGcpStorageBucket(_ context.Context) *plugin.Table {
 				Hydrate:     getGcpStorageBucketIAMPolicy,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "iam_policy_bindings",
+				Description: "The bindings of the bucket's IAM policy expanded to one entry per role and member, along with the title and expression of the condition of the binding, if any.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGcpStorageBucketIAMPolicy,
+				Transform:   transform.FromValue().Transform(storageBucketIamPolicyBindings),
+			},
+			{
+				Name:        "iam_public_access",
+				Description: "True if the bucket's IAM policy grants a role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGcpStorageBucketIAMPolicy,
+				Transform:   transform.FromValue().Transform(storageBucketIamPublicAccess),
+			},
+			{
+				Name:        "acl_entries",
+				Description: "The entries of the bucket's access-control list and default object access-control list, along with the list they come from. Both lists are empty when uniform bucket-level access is enabled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageBucketAclEntries),
+			},
+			{
+				Name:        "acl_public_access",
+				Description: "True if the bucket's access-control list or default object access-control list grants access to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(storageBucketAclPublicAccess),
+			},
 			{
 				Name:        "lifecycle_rules",
 				Description: "The bucket's lifecycle configuration. See lifecycle management for more information.",
@@ -326,7 +390,8 @@ func getGcpStorageBucketIAMPolicy(ctx context.Context, d *plugin.QueryData, h *p
 		return nil, err
 	}
 
-	resp, err := service.Buckets.GetIamPolicy(bucket.Name).Do()
+	// Version 3 is required to get the conditions of the conditional role bindings
+	resp, err := service.Buckets.GetIamPolicy(bucket.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -365,3 +430,92 @@ func extractRetentionPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	return bucketRetentionPolicy, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+// storageBucketPublicEntities are the principals that make a bucket or its objects public
+var storageBucketPublicEntities = []string{"allUsers", "allAuthenticatedUsers"}
+
+func storageBucketIamPolicyBindings(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.Value.(*storage.Policy)
+	if !ok || policy == nil {
+		return nil, nil
+	}
+
+	var bindings []map[string]interface{}
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			item := map[string]interface{}{
+				"role":      binding.Role,
+				"member":    member,
+				"is_public": slices.Contains(storageBucketPublicEntities, member),
+			}
+			if binding.Condition != nil {
+				item["condition_title"] = binding.Condition.Title
+				item["condition_description"] = binding.Condition.Description
+				item["condition_expression"] = binding.Condition.Expression
+			}
+			bindings = append(bindings, item)
+		}
+	}
+
+	return bindings, nil
+}
+
+func storageBucketIamPublicAccess(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.Value.(*storage.Policy)
+	if !ok || policy == nil {
+		return false, nil
+	}
+
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			if slices.Contains(storageBucketPublicEntities, member) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func storageBucketAclEntries(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bucket := d.HydrateItem.(*storage.Bucket)
+
+	var entries []map[string]interface{}
+	for _, acl := range bucket.Acl {
+		entries = append(entries, map[string]interface{}{
+			"source":    "acl",
+			"entity":    acl.Entity,
+			"role":      acl.Role,
+			"is_public": slices.Contains(storageBucketPublicEntities, acl.Entity),
+		})
+	}
+	for _, acl := range bucket.DefaultObjectAcl {
+		entries = append(entries, map[string]interface{}{
+			"source":    "default_object_acl",
+			"entity":    acl.Entity,
+			"role":      acl.Role,
+			"is_public": slices.Contains(storageBucketPublicEntities, acl.Entity),
+		})
+	}
+
+	return entries, nil
+}
+
+func storageBucketAclPublicAccess(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bucket := d.HydrateItem.(*storage.Bucket)
+
+	for _, acl := range bucket.Acl {
+		if slices.Contains(storageBucketPublicEntities, acl.Entity) {
+			return true, nil
+		}
+	}
+	for _, acl := range bucket.DefaultObjectAcl {
+		if slices.Contains(storageBucketPublicEntities, acl.Entity) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -81,7 +81,7 @@ func listGcpTagBindings(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 
 	// Create Service Connection
-	client, err := resourcemanager.NewTagBindingsClient(ctx)
+	client, err := resourcemanager.NewTagBindingsClient(ctx, setSessionConfig(ctx, d.Connection)...)
 	if err != nil {
 		return nil, err
 	}
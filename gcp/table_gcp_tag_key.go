@@ -0,0 +1,195 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpTagKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_tag_key",
+		Description: "GCP Tag Key",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getGcpTagKey,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "tagKeys.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGcpTagKeys,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "resourcemanager", "action": "tagKeys.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the tag key, in the form tagKeys/{tag_key_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "short_name",
+				Description: "The user friendly name for the tag key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespaced_name",
+				Description: "Namespaced name of the tag key, in the form {parent_id}/{short_name}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent",
+				Description: "The resource name of the tag key's parent, in the form organizations/{org_id} or projects/{project_number}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "User-assigned description of the tag key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "purpose",
+				Description: "A purpose denotes that this tag key is intended for use in policies of a specific policy engine, for example GCE_FIREWALL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Update time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "Entity tag which users can pass to prevent race conditions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "purpose_data",
+				Description: "Purpose data corresponds to the policy system that the tag is intended for, for example the network the GCE_FIREWALL tag key applies to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamespacedName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudresourcemanager.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpTagKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_key.listGcpTagKeys", "service_error", err)
+		return nil, err
+	}
+
+	// List the tag keys of the connection's project, unless another parent is given
+	parent := d.EqualsQualString("parent")
+	if parent == "" {
+		projectId, err := getProject(ctx, d, h)
+		if err != nil {
+			return nil, err
+		}
+		parent = "projects/" + projectId.(string)
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.TagKeys.List().Parent(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudresourcemanager3.ListTagKeysResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, tagKey := range page.TagKeys {
+			d.StreamListItem(ctx, tagKey)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_key.listGcpTagKeys", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpTagKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_key.getGcpTagKey", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.TagKeys.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_key.getGcpTagKey", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,184 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpTagValue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_tag_value",
+		Description: "GCP Tag Value",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getGcpTagValue,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "tagValues.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpTagKeys,
+			Hydrate:       listGcpTagValues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "tag_key", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "resourcemanager", "action": "tagValues.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the tag value, in the form tagValues/{tag_value_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "short_name",
+				Description: "The user friendly name for the tag value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespaced_name",
+				Description: "Namespaced name of the tag value, in the form {parent_id}/{tag_key_short_name}/{short_name}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_key",
+				Description: "The resource name of the tag value's parent tag key, in the form tagKeys/{tag_key_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parent"),
+			},
+			{
+				Name:        "description",
+				Description: "User-assigned description of the tag value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "Update time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "Entity tag which users can pass to prevent race conditions.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamespacedName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "cloudresourcemanager.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGcpTagValues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tagKey := h.Item.(*cloudresourcemanager3.TagKey)
+
+	// Minimize the API call with the given tag key
+	if key := d.EqualsQualString("tag_key"); key != "" && key != tagKey.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_value.listGcpTagValues", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.TagValues.List().Parent(tagKey.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *cloudresourcemanager3.ListTagValuesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, tagValue := range page.TagValues {
+			d.StreamListItem(ctx, tagValue)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_value.listGcpTagValues", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGcpTagValue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_value.getGcpTagValue", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.TagValues.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_value.getGcpTagValue", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,319 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/aiplatform/apiv1/aiplatformpb"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/iterator"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpVertexAICustomJob(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_vertex_ai_custom_job",
+		Description: "GCP Vertex AI Custom Job",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			Hydrate:           getAIPlatformCustomJob,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			Tags:              map[string]string{"service": "aiplatform", "action": "customJobs.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:           listAIPlatformCustomJobs,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "aiplatform", "action": "customJobs.list"},
+		},
+		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Job"),
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the CustomJob.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the CustomJob.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The detailed state of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Time when the CustomJob was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "start_time",
+				Description: "Time when the CustomJob for the first time entered the JOB_STATE_RUNNING state.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "end_time",
+				Description: "Time when the CustomJob entered any of the following states: JOB_STATE_SUCCEEDED, JOB_STATE_FAILED, JOB_STATE_CANCELLED.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EndTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "update_time",
+				Description: "Time when the CustomJob was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "error_message",
+				Description: "The error message, only populated when the job's state is JOB_STATE_FAILED or JOB_STATE_CANCELLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Error.Message"),
+			},
+			{
+				Name:        "service_account",
+				Description: "Specifies the service account for workload run-as account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobSpec.ServiceAccount"),
+			},
+			{
+				Name:        "network",
+				Description: "The full name of the Compute Engine network to which the Job should be peered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobSpec.Network"),
+			},
+			{
+				Name:        "enable_web_access",
+				Description: "Whether you want Vertex AI to enable interactive shell access to training containers.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("JobSpec.EnableWebAccess"),
+			},
+			{
+				Name:        "enable_dashboard_access",
+				Description: "Whether you want Vertex AI to enable access to the customized dashboard in training chief container.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("JobSpec.EnableDashboardAccess"),
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS resource identifier of the customer managed encryption key used to protect the CustomJob.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionSpec.KmsKeyName"),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON columns
+			{
+				Name:        "worker_pool_specs",
+				Description: "The spec of the worker pools including machine type and Docker image.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("JobSpec.WorkerPoolSpecs"),
+			},
+			{
+				Name:        "job_spec",
+				Description: "Job spec.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "error",
+				Description: "Only populated when job's state is JOB_STATE_FAILED or JOB_STATE_CANCELLED.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "encryption_spec",
+				Description: "Customer-managed encryption key options for a CustomJob. If this is set, then all resources created by the CustomJob will be encrypted with the provided encryption key.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "web_access_uris",
+				Description: "URIs for accessing interactive shells (one URI for each training node).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels with user-defined metadata to organize CustomJobs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "aiplatform.googleapis.com"),
+			},
+			// Standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAIPlatformCustomJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	region := d.EqualsQualString("location")
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		return nil, nil
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_custom_job.listAIPlatformCustomJobs", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Page size should be in range of [0, 100].
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Job")
+	if err != nil {
+		logger.Error("gcp_vertex_ai_custom_job.listAIPlatformCustomJobs", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.ListCustomJobsRequest{
+		Parent:   "projects/" + project + "/locations/" + location,
+		PageSize: int32(*pageSize),
+	}
+
+	it := service.Job.ListCustomJobs(ctx, req)
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		job, err := it.Next()
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, nil
+			}
+			if err == iterator.Done {
+				break
+			}
+			logger.Error("gcp_vertex_ai_custom_job.listAIPlatformCustomJobs", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, job)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAIPlatformCustomJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_custom_job.getAIPlatformCustomJob", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	name := d.EqualsQualString("name")
+
+	// Validate - name should not be blank
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Job")
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_custom_job.getAIPlatformCustomJob", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.GetCustomJobRequest{
+		Name: "projects/" + project + "/locations/" + matrixLocation + "/customJobs/" + name,
+	}
+
+	result, err := service.Job.GetCustomJob(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_custom_job.getAIPlatformCustomJob", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,291 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/aiplatform/apiv1/aiplatformpb"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/iterator"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpVertexAIDataset(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_vertex_ai_dataset",
+		Description: "GCP Vertex AI Dataset",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			Hydrate:           getAIPlatformDataset,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			Tags:              map[string]string{"service": "aiplatform", "action": "datasets.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:           listAIPlatformDatasets,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "aiplatform", "action": "datasets.list"},
+		},
+		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Dataset"),
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the Dataset.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The user-defined name of the Dataset.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the Dataset.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metadata_schema_uri",
+				Description: "Points to a YAML file stored on Google Cloud Storage describing additional information about the Dataset.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_item_count",
+				Description: "The number of DataItems in this Dataset. Only apply for non-structured Dataset.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "create_time",
+				Description: "Timestamp when this Dataset was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "update_time",
+				Description: "Timestamp when this Dataset was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "etag",
+				Description: "Used to perform consistent read-modify-write updates. If not set, a blind 'overwrite' update happens.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS resource identifier of the customer managed encryption key used to protect the Dataset.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionSpec.KmsKeyName"),
+			},
+			{
+				Name:        "metadata_artifact",
+				Description: "The resource name of the Artifact that was created in MetadataStore when creating the Dataset.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "model_reference",
+				Description: "Reference to the public base model last used by the dataset. Only set for prompt datasets.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON columns
+			{
+				Name:        "metadata",
+				Description: "Additional information about the Dataset.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "encryption_spec",
+				Description: "Customer-managed encryption key spec for a Dataset. If set, this Dataset and all sub-resources of this Dataset will be secured by this key.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "saved_queries",
+				Description: "All SavedQueries belong to the Dataset will be returned in List/Get Dataset response.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels with user-defined metadata to organize your Datasets.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "aiplatform.googleapis.com"),
+			},
+			// Standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAIPlatformDatasets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	region := d.EqualsQualString("location")
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		return nil, nil
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_dataset.listAIPlatformDatasets", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Page size should be in range of [0, 100].
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Dataset")
+	if err != nil {
+		logger.Error("gcp_vertex_ai_dataset.listAIPlatformDatasets", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.ListDatasetsRequest{
+		Parent:   "projects/" + project + "/locations/" + location,
+		PageSize: int32(*pageSize),
+	}
+
+	it := service.Dataset.ListDatasets(ctx, req)
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		dataset, err := it.Next()
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, nil
+			}
+			if err == iterator.Done {
+				break
+			}
+			logger.Error("gcp_vertex_ai_dataset.listAIPlatformDatasets", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, dataset)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAIPlatformDataset(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_dataset.getAIPlatformDataset", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	name := d.EqualsQualString("name")
+
+	// Validate - name should not be blank
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Dataset")
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_dataset.getAIPlatformDataset", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.GetDatasetRequest{
+		Name: "projects/" + project + "/locations/" + matrixLocation + "/datasets/" + name,
+	}
+
+	result, err := service.Dataset.GetDataset(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_dataset.getAIPlatformDataset", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
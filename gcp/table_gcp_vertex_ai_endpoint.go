@@ -88,6 +88,12 @@ func tableGcpVertexAIEndpoint(_ context.Context) *plugin.Table {
 				Description: "The models deployed in this Endpoint. To add or remove DeployedModels use EndpointService.DeployModel and EndpointService.UndeployModel respectively.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "deployed_model_machine_specs",
+				Description: "The machine type, accelerators and replica counts of the dedicated resources used by each model deployed in this Endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DeployedModels").Transform(aiPlatformEndpointDeployedModelMachineSpecs),
+			},
 			{
 				Name:        "encryption_spec",
 				Description: "Customer-managed encryption key spec for an Endpoint. If set, this Endpoint and all sub-resources of this Endpoint will be secured by this key.",
@@ -285,6 +291,33 @@ func gcpAIPlatformTurbotData(ctx context.Context, d *transform.TransformData) (i
 	return turbotData[param], nil
 }
 
+func aiPlatformEndpointDeployedModelMachineSpecs(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	deployedModels, ok := d.Value.([]*aiplatformpb.DeployedModel)
+	if !ok {
+		return nil, nil
+	}
+
+	var machineSpecs []map[string]interface{}
+	for _, deployedModel := range deployedModels {
+		resources := deployedModel.GetDedicatedResources()
+		if resources == nil {
+			continue
+		}
+		machineSpec := map[string]interface{}{
+			"deployed_model_id": deployedModel.GetId(),
+			"min_replica_count": resources.GetMinReplicaCount(),
+			"max_replica_count": resources.GetMaxReplicaCount(),
+		}
+		if spec := resources.GetMachineSpec(); spec != nil {
+			machineSpec["machine_type"] = spec.GetMachineType()
+			machineSpec["accelerator_type"] = spec.GetAcceleratorType().String()
+			machineSpec["accelerator_count"] = spec.GetAcceleratorCount()
+		}
+		machineSpecs = append(machineSpecs, machineSpec)
+	}
+	return machineSpecs, nil
+}
+
 func convertTimestamppbAsTime(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	v := d.Value
 	if v != nil {
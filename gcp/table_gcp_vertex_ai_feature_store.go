@@ -0,0 +1,272 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/aiplatform/apiv1/aiplatformpb"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/iterator"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpVertexAIFeatureStore(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_vertex_ai_feature_store",
+		Description: "GCP Vertex AI Feature Store",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			Hydrate:           getAIPlatformFeatureStore,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			Tags:              map[string]string{"service": "aiplatform", "action": "featurestores.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:           listAIPlatformFeatureStores,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "aiplatform", "action": "featurestores.list"},
+		},
+		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Featurestore"),
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Featurestore.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "state",
+				Description: "State of the featurestore.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Timestamp when this Featurestore was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "update_time",
+				Description: "Timestamp when this Featurestore was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "etag",
+				Description: "Used to perform consistent read-modify-write updates. If not set, a blind 'overwrite' update happens.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "online_serving_fixed_node_count",
+				Description: "The number of nodes for the online store. Only set when online serving uses a fixed number of nodes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("OnlineServingConfig.FixedNodeCount"),
+			},
+			{
+				Name:        "online_storage_ttl_days",
+				Description: "The number of days to keep the data in the online store.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS resource identifier of the customer managed encryption key used to protect the Featurestore.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionSpec.KmsKeyName"),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON columns
+			{
+				Name:        "online_serving_config",
+				Description: "Config for online storage resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "encryption_spec",
+				Description: "Customer-managed encryption key spec for data storage. If set, both of the online and offline data storage will be secured by this key.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels with user-defined metadata to organize your Featurestore.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "aiplatform.googleapis.com"),
+			},
+			// Standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAIPlatformFeatureStores(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	region := d.EqualsQualString("location")
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		return nil, nil
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store.listAIPlatformFeatureStores", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Page size should be in range of [0, 100].
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Featurestore")
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store.listAIPlatformFeatureStores", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.ListFeaturestoresRequest{
+		Parent:   "projects/" + project + "/locations/" + location,
+		PageSize: int32(*pageSize),
+	}
+
+	it := service.Featurestore.ListFeaturestores(ctx, req)
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		featureStore, err := it.Next()
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, nil
+			}
+			if err == iterator.Done {
+				break
+			}
+			logger.Error("gcp_vertex_ai_feature_store.listAIPlatformFeatureStores", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, featureStore)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAIPlatformFeatureStore(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store.getAIPlatformFeatureStore", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	name := d.EqualsQualString("name")
+
+	// Validate - name should not be blank
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Featurestore")
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_feature_store.getAIPlatformFeatureStore", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.GetFeaturestoreRequest{
+		Name: "projects/" + project + "/locations/" + matrixLocation + "/featurestores/" + name,
+	}
+
+	result, err := service.Featurestore.GetFeaturestore(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_feature_store.getAIPlatformFeatureStore", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,250 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/aiplatform/apiv1/aiplatformpb"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/iterator"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpVertexAIFeatureStoreEntityType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_vertex_ai_feature_store_entity_type",
+		Description: "GCP Vertex AI Feature Store Entity Type",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.AllColumns([]string{"feature_store_name", "name"}),
+			Hydrate:           getAIPlatformFeatureStoreEntityType,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			Tags:              map[string]string{"service": "aiplatform", "action": "featurestores.entityTypes.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate:     listAIPlatformFeatureStores,
+			Hydrate:           listAIPlatformFeatureStoreEntityTypes,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "feature_store_name", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "aiplatform", "action": "featurestores.entityTypes.list"},
+		},
+		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Featurestore"),
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the EntityType.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "feature_store_name",
+				Description: "The name of the Featurestore the EntityType belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the EntityType.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Timestamp when this EntityType was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "update_time",
+				Description: "Timestamp when this EntityType was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "etag",
+				Description: "Used to perform a consistent read-modify-write updates. If not set, a blind 'overwrite' update happens.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "offline_storage_ttl_days",
+				Description: "Config for data retention policy in offline storage. TTL in days for feature values that will be stored in offline storage.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON columns
+			{
+				Name:        "monitoring_config",
+				Description: "The default monitoring configuration for all Features with value type (Feature.ValueType) BOOL, STRING, DOUBLE or INT64 under this EntityType.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels with user-defined metadata to organize your EntityTypes.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "aiplatform.googleapis.com"),
+			},
+			// Standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAIPlatformFeatureStoreEntityTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	featureStore := h.Item.(*aiplatformpb.Featurestore)
+
+	// Minimize the API call with the given feature store name
+	featureStoreName := d.EqualsQualString("feature_store_name")
+	if featureStoreName != "" && featureStoreName != getLastPathElement(featureStore.Name) {
+		return nil, nil
+	}
+
+	// Page size should be in range of [0, 100].
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Featurestore")
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store_entity_type.listAIPlatformFeatureStoreEntityTypes", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.ListEntityTypesRequest{
+		Parent:   featureStore.Name,
+		PageSize: int32(*pageSize),
+	}
+
+	it := service.Featurestore.ListEntityTypes(ctx, req)
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		entityType, err := it.Next()
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, nil
+			}
+			if err == iterator.Done {
+				break
+			}
+			logger.Error("gcp_vertex_ai_feature_store_entity_type.listAIPlatformFeatureStoreEntityTypes", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, entityType)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAIPlatformFeatureStoreEntityType(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store_entity_type.getAIPlatformFeatureStoreEntityType", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	featureStoreName := d.EqualsQualString("feature_store_name")
+	name := d.EqualsQualString("name")
+
+	// Validate - feature store name and name should not be blank
+	if featureStoreName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Featurestore")
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_feature_store_entity_type.getAIPlatformFeatureStoreEntityType", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.GetEntityTypeRequest{
+		Name: "projects/" + project + "/locations/" + matrixLocation + "/featurestores/" + featureStoreName + "/entityTypes/" + name,
+	}
+
+	result, err := service.Featurestore.GetEntityType(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_feature_store_entity_type.getAIPlatformFeatureStoreEntityType", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
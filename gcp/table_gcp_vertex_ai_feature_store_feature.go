@@ -0,0 +1,291 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/aiplatform/apiv1/aiplatformpb"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/iterator"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpVertexAIFeatureStoreFeature(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_vertex_ai_feature_store_feature",
+		Description: "GCP Vertex AI Feature Store Feature",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.AllColumns([]string{"feature_store_name", "entity_type_name", "name"}),
+			Hydrate:           getAIPlatformFeatureStoreFeature,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			Tags:              map[string]string{"service": "aiplatform", "action": "featurestores.entityTypes.features.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:           listAIPlatformFeatureStoreFeatures,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "feature_store_name", Require: plugin.Optional},
+				{Name: "entity_type_name", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "aiplatform", "action": "featurestores.searchFeatures"},
+		},
+		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Featurestore"),
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Feature.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "feature_store_name",
+				Description: "The name of the Featurestore the Feature belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "entity_type_name",
+				Description: "The name of the EntityType the Feature belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 7),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the Feature.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_type",
+				Description: "Type of Feature value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Timestamp when this Feature was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "update_time",
+				Description: "Timestamp when this Feature was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "etag",
+				Description: "Used to perform a consistent read-modify-write updates. If not set, a blind 'overwrite' update happens.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disable_monitoring",
+				Description: "If true, feature monitoring is disabled for this Feature.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "version_column_name",
+				Description: "Only applicable for Vertex AI Feature Store. The name of the BigQuery Table/View column hosting data for this version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "point_of_contact",
+				Description: "Entity responsible for maintaining this feature.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON columns
+			{
+				Name:        "monitoring_stats_anomalies",
+				Description: "The list of historical stats and anomalies with specified objectives.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels with user-defined metadata to organize your Features.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "aiplatform.googleapis.com"),
+			},
+			// Standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAIPlatformFeatureStoreFeatures(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	region := d.EqualsQualString("location")
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		return nil, nil
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store_feature.listAIPlatformFeatureStoreFeatures", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Page size should be in range of [0, 100].
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Featurestore")
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store_feature.listAIPlatformFeatureStoreFeatures", "service_error", err)
+		return nil, err
+	}
+
+	// Search the features of all the featurestores of the location at once
+	req := &aiplatformpb.SearchFeaturesRequest{
+		Location: "projects/" + project + "/locations/" + location,
+		PageSize: int32(*pageSize),
+	}
+
+	featureStoreName := d.EqualsQualString("feature_store_name")
+	entityTypeName := d.EqualsQualString("entity_type_name")
+
+	it := service.Featurestore.SearchFeatures(ctx, req)
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		feature, err := it.Next()
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, nil
+			}
+			if err == iterator.Done {
+				break
+			}
+			logger.Error("gcp_vertex_ai_feature_store_feature.listAIPlatformFeatureStoreFeatures", "api_error", err)
+			return nil, err
+		}
+
+		// Feature names have the form projects/{project}/locations/{location}/featurestores/{featurestore}/entityTypes/{entity_type}/features/{feature}
+		parts := strings.Split(feature.Name, "/")
+		if len(parts) > 7 {
+			if featureStoreName != "" && featureStoreName != parts[5] {
+				continue
+			}
+			if entityTypeName != "" && entityTypeName != parts[7] {
+				continue
+			}
+		}
+
+		d.StreamListItem(ctx, feature)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAIPlatformFeatureStoreFeature(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_feature_store_feature.getAIPlatformFeatureStoreFeature", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	featureStoreName := d.EqualsQualString("feature_store_name")
+	entityTypeName := d.EqualsQualString("entity_type_name")
+	name := d.EqualsQualString("name")
+
+	// Validate - feature store name, entity type name and name should not be blank
+	if featureStoreName == "" || entityTypeName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Featurestore")
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_feature_store_feature.getAIPlatformFeatureStoreFeature", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.GetFeatureRequest{
+		Name: "projects/" + project + "/locations/" + matrixLocation + "/featurestores/" + featureStoreName + "/entityTypes/" + entityTypeName + "/features/" + name,
+	}
+
+	result, err := service.Featurestore.GetFeature(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_feature_store_feature.getAIPlatformFeatureStoreFeature", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
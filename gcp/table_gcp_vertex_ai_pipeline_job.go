@@ -0,0 +1,319 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/aiplatform/apiv1/aiplatformpb"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/iterator"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpVertexAIPipelineJob(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_vertex_ai_pipeline_job",
+		Description: "GCP Vertex AI Pipeline Job",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			Hydrate:           getAIPlatformPipelineJob,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			Tags:              map[string]string{"service": "aiplatform", "action": "pipelineJobs.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:           listAIPlatformPipelineJobs,
+			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "aiplatform", "action": "pipelineJobs.list"},
+		},
+		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Pipeline"),
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the PipelineJob.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the Pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The detailed state of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "Pipeline creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "start_time",
+				Description: "Pipeline start time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "end_time",
+				Description: "Pipeline end time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EndTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "update_time",
+				Description: "Timestamp when this PipelineJob was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").Transform(convertTimestamppbAsTime),
+			},
+			{
+				Name:        "error_message",
+				Description: "The error message, only populated when the pipeline's state is FAILED or CANCELLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Error.Message"),
+			},
+			{
+				Name:        "service_account",
+				Description: "The service account that the pipeline workload runs as.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network",
+				Description: "The full name of the Compute Engine network to which the Pipeline Job's workload should be peered.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gcs_output_directory",
+				Description: "A path in a Cloud Storage bucket, which will be treated as the root output directory of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RuntimeConfig.GcsOutputDirectory"),
+			},
+			{
+				Name:        "template_uri",
+				Description: "A template uri from where the PipelineJob.pipeline_spec, if empty, will be downloaded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule_name",
+				Description: "The schedule resource name. Only returned if the Pipeline is created by Schedule API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The Cloud KMS resource identifier of the customer managed encryption key used to protect the PipelineJob.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EncryptionSpec.KmsKeyName"),
+			},
+			{
+				Name:        "preflight_validations",
+				Description: "Whether to do component level validations before job creation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON columns
+			{
+				Name:        "runtime_config",
+				Description: "Runtime config of the pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "error",
+				Description: "The error that occurred during pipeline execution. Only populated when the pipeline's state is FAILED or CANCELLED.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "encryption_spec",
+				Description: "Customer-managed encryption key spec for a pipelineJob. If set, this PipelineJob and all of its sub-resources will be secured by this key.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "template_metadata",
+				Description: "Pipeline template metadata. Will fill up fields if PipelineJob.template_uri is from supported template registry.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reserved_ip_ranges",
+				Description: "A list of names for the reserved ip ranges under the VPC network that can be used for this Pipeline Job's workload.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "The labels with user-defined metadata to organize PipelineJob.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName", "Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "aiplatform.googleapis.com"),
+			},
+			// Standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAIPlatformPipelineJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	region := d.EqualsQualString("location")
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		return nil, nil
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_pipeline_job.listAIPlatformPipelineJobs", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Page size should be in range of [0, 100].
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Pipeline")
+	if err != nil {
+		logger.Error("gcp_vertex_ai_pipeline_job.listAIPlatformPipelineJobs", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.ListPipelineJobsRequest{
+		Parent:   "projects/" + project + "/locations/" + location,
+		PageSize: int32(*pageSize),
+	}
+	if state := d.EqualsQualString("state"); state != "" {
+		req.Filter = "state=\"" + state + "\""
+	}
+
+	it := service.Pipeline.ListPipelineJobs(ctx, req)
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		job, err := it.Next()
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, nil
+			}
+			if err == iterator.Done {
+				break
+			}
+			logger.Error("gcp_vertex_ai_pipeline_job.listAIPlatformPipelineJobs", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, job)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAIPlatformPipelineJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_vertex_ai_pipeline_job.getAIPlatformPipelineJob", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	name := d.EqualsQualString("name")
+
+	// Validate - name should not be blank
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := AIService(ctx, d, "Pipeline")
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_pipeline_job.getAIPlatformPipelineJob", "service_error", err)
+		return nil, err
+	}
+
+	req := &aiplatformpb.GetPipelineJobRequest{
+		Name: "projects/" + project + "/locations/" + matrixLocation + "/pipelineJobs/" + name,
+	}
+
+	result, err := service.Pipeline.GetPipelineJob(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		logger.Error("gcp_vertex_ai_pipeline_job.getAIPlatformPipelineJob", "api_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
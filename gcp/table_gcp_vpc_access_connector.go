@@ -20,9 +20,11 @@ func tableGcpVPCAccessConnector(ctx context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("name"),
 			Hydrate:    getVPCAccessConnector,
+			Tags:       map[string]string{"service": "vpcaccess", "action": "connectors.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listVPCAccessConnectors,
+			Tags:    map[string]string{"service": "vpcaccess", "action": "connectors.list"},
 		},
 		GetMatrixItemFunc: BuildVPCAccessLocationList,
 		Columns: []*plugin.Column{
@@ -69,7 +71,7 @@ func tableGcpVPCAccessConnector(ctx context.Context) *plugin.Table {
 			},
 			{
 				Name:        "min_instances",
-				Description: "Minimum throughput of the connector in Mbps.",
+				Description: "Minimum value of instances in autoscaling group underlying the connector.",
 				Type:        proto.ColumnType_INT,
 			},
 			{
@@ -158,6 +160,9 @@ func listVPCAccessConnectors(ctx context.Context, d *plugin.QueryData, h *plugin
 
 	resp := service.Projects.Locations.Connectors.List(parent).PageSize(*pageSize)
 	if err := resp.Pages(ctx, func(page *vpcaccess.ListConnectorsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
 		for _, item := range page.Connectors {
 			d.StreamListItem(ctx, item)
 
@@ -202,6 +207,7 @@ func getVPCAccessConnector(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 	resp, err := service.Projects.Locations.Connectors.Get(name).Do()
 	if err != nil {
+		plugin.Logger(ctx).Error("gcp_vpc_access_connector.getVPCAccessConnector", "api_error", err)
 		return nil, err
 	}
 
@@ -0,0 +1,279 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/workflowexecutions/v1"
+	"google.golang.org/api/workflows/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpWorkflowsExecution(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_workflows_execution",
+		Description: "GCP Workflows Execution",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "workflow_name", "location"}),
+			Hydrate:    getWorkflowsExecution,
+			Tags:       map[string]string{"service": "workflowexecutions", "action": "executions.get"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listWorkflowsWorkflows,
+			Hydrate:       listWorkflowsExecutions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workflow_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "state",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "workflowexecutions", "action": "executions.list"},
+		},
+		GetMatrixItemFunc: BuildWorkflowsLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow the execution belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 5),
+			},
+			{
+				Name:        "state",
+				Description: "Current state of the execution (ACTIVE, SUCCEEDED, FAILED, CANCELLED, UNAVAILABLE or QUEUED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workflow_revision_id",
+				Description: "Revision of the workflow this execution is using.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the execution was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "start_time",
+				Description: "Time the execution started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime").NullIfZero(),
+			},
+			{
+				Name:        "end_time",
+				Description: "Time the execution finished, whether it was successful, failed or cancelled.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EndTime").NullIfZero(),
+			},
+			{
+				Name:        "duration",
+				Description: "Measures the duration of the execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error_payload",
+				Description: "Error message and data returned represented as a JSON string, if the execution failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Error.Payload"),
+			},
+			{
+				Name:        "error_context",
+				Description: "Human-readable stack trace string, if the execution failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Error.Context"),
+			},
+			{
+				Name:        "call_log_level",
+				Description: "The call logging level associated to this execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "execution_history_level",
+				Description: "Describes the level of the execution history feature to apply to this execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disable_concurrency_quota_overflow_buffering",
+				Description: "Whether the execution is queued when the concurrency quota is exceeded, or fails instead.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "argument",
+				Description: "Input parameters of the execution represented as a JSON string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "result",
+				Description: "Output of the execution represented as a JSON string, if the execution succeeded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error",
+				Description: "The error which caused the execution to finish prematurely.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "state_error",
+				Description: "Error regarding the state of the execution resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status",
+				Description: "Status tracks the current steps and progress data of the execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels associated with this execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "workflowexecutions.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowsExecutions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflow := h.Item.(*workflows.Workflow)
+
+	// Minimize the API call with the given workflow name
+	workflowName := d.EqualsQualString("workflow_name")
+	if workflowName != "" && workflowName != getLastPathElement(workflow.Name) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := WorkflowExecutionsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_execution.listWorkflowsExecutions", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation for the FULL view
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Executions are returned newest first
+	resp := service.Projects.Locations.Workflows.Executions.List(workflow.Name).View("FULL").PageSize(*pageSize)
+	if state := d.EqualsQualString("state"); state != "" {
+		resp = resp.Filter("state=\"" + state + "\"")
+	}
+	if err := resp.Pages(ctx, func(page *workflowexecutions.ListExecutionsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, execution := range page.Executions {
+			d.StreamListItem(ctx, execution)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_execution.listWorkflowsExecutions", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWorkflowsExecution(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	workflowName := d.EqualsQualString("workflow_name")
+	location := d.EqualsQualString("location")
+
+	// Empty Check
+	if name == "" || workflowName == "" || location == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call to the matching matrix location
+	if location != d.EqualsQualString(matrixKeyLocation) {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := WorkflowExecutionsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_execution.getWorkflowsExecution", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Locations.Workflows.Executions.Get("projects/" + project + "/locations/" + location + "/workflows/" + workflowName + "/executions/" + name).View("FULL").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_execution.getWorkflowsExecution", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
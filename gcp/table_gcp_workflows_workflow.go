@@ -0,0 +1,271 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/workflows/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpWorkflowsWorkflow(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_workflows_workflow",
+		Description: "GCP Workflows Workflow",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
+			Hydrate:    getWorkflowsWorkflow,
+			Tags:       map[string]string{"service": "workflows", "action": "workflows.get"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listWorkflowsWorkflows,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+			Tags: map[string]string{"service": "workflows", "action": "workflows.list"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getWorkflowsWorkflow,
+				Tags: map[string]string{"service": "workflows", "action": "workflows.get"},
+			},
+		},
+		GetMatrixItemFunc: BuildWorkflowsLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the workflow.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the workflow provided by the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the workflow deployment (ACTIVE or UNAVAILABLE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision_id",
+				Description: "The revision of the workflow. A new revision is created when the service account, source contents or KMS key of the workflow is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The timestamp for when the workflow was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The timestamp for when the workflow was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "revision_create_time",
+				Description: "The timestamp for the latest revision of the workflow's creation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RevisionCreateTime").NullIfZero(),
+			},
+			{
+				Name:        "service_account",
+				Description: "The service account associated with the latest workflow version. This service account represents the identity of the workflow and determines what permissions the workflow has.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "crypto_key_name",
+				Description: "The resource name of a KMS crypto key used to encrypt or decrypt the data associated with the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "crypto_key_version",
+				Description: "The resource name of a KMS crypto key version used to encrypt or decrypt the data associated with the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "call_log_level",
+				Description: "Describes the level of platform logging to apply to calls and call responses during executions of this workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "execution_history_level",
+				Description: "Describes the level of the execution history feature to apply to this workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_contents",
+				Description: "Workflow code to be executed.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getWorkflowsWorkflow,
+			},
+			{
+				Name:        "all_kms_keys",
+				Description: "A list of all KMS crypto keys used to encrypt or decrypt the data associated with the workflow.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "state_error",
+				Description: "Error regarding the state of the workflow. For example, this field will have error details if the execution data is unavailable due to revoked KMS key permissions.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_env_vars",
+				Description: "User-defined environment variables associated with this workflow revision.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels associated with this workflow.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").TransformP(locationResourceAkas, "workflows.googleapis.com"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 3),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").TransformP(locationResourceNamePart, 1),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowsWorkflows(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := d.EqualsQualString(matrixKeyLocation)
+
+	// The matrix location is empty when the service API is disabled
+	if location == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := WorkflowsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_workflow.listWorkflowsWorkflows", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.Locations.Workflows.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *workflows.ListWorkflowsResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, workflow := range page.Workflows {
+			d.StreamListItem(ctx, workflow)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_workflow.listWorkflowsWorkflows", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWorkflowsWorkflow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var workflowName string
+	if h.Item != nil {
+		workflowName = h.Item.(*workflows.Workflow).Name
+	} else {
+		name := d.EqualsQualString("name")
+		location := d.EqualsQualString("location")
+
+		// Empty Check
+		if name == "" || location == "" {
+			return nil, nil
+		}
+
+		// Restrict the API call to the matching matrix location
+		if location != d.EqualsQualString(matrixKeyLocation) {
+			return nil, nil
+		}
+
+		// Get project details
+		projectId, err := getProject(ctx, d, h)
+		if err != nil {
+			return nil, err
+		}
+		project := projectId.(string)
+
+		workflowName = "projects/" + project + "/locations/" + location + "/workflows/" + name
+	}
+
+	// Create Service Connection
+	service, err := WorkflowsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_workflow.getWorkflowsWorkflow", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Workflows.Get(workflowName).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_workflows_workflow.getWorkflowsWorkflow", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/mitchellh/go-homedir"
@@ -50,6 +51,24 @@ func lastPathElement(_ context.Context, d *transform.TransformData) (interface{}
 	return getLastPathElement(types.SafeString(d.Value)), nil
 }
 
+// Regional resource names have the form projects/{project}/locations/{location}/...
+func locationResourceNamePart(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	parts := strings.Split(types.SafeString(d.Value), "/")
+	position := d.Param.(int)
+	if len(parts) <= position {
+		return nil, nil
+	}
+	return parts[position], nil
+}
+
+func locationResourceAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := types.SafeString(d.Value)
+	if name == "" {
+		return nil, nil
+	}
+	return []string{"gcp://" + d.Param.(string) + "/" + name}, nil
+}
+
 // if the caching is required other than per connection, build a cache key for the call and use it in Memoize
 // since getProject is a call, caching should be per connection
 var getProjectMemoized = plugin.HydrateFunc(getProjectUncached).Memoize(memoize.WithCacheKeyFunction(getProjectCacheKey))
@@ -334,6 +353,8 @@ func buildQueryFilter(filterQuals []filterQualMap, equalQuals plugin.KeyColumnEq
  *
  * Output: []string{"(cpuPlatform = \"Intel Haswell\")", "((status = \"TERMINATED\") OR (status = \"RUNNING\"))", "(deletionProtection = false)"}
  *
+ * Timestamp quals are formatted in RFC3339 with sub-second precision, for example "(create_time >= \"2024-05-01T00:00:00Z\")".
+ *
  * This can be used for almost all the API's in GCP if it supports filter option
  */
 func buildQueryFilterFromQuals(filterQuals []filterQualMap, equalQuals plugin.KeyColumnQualMap) []string {
@@ -383,6 +404,14 @@ func buildQueryFilterFromQuals(filterQuals []filterQualMap, equalQuals plugin.Ke
 						case "=":
 							filters = append(filters, fmt.Sprintf("(%s = %t)", filterQualItem.PropertyPath, boolValue))
 						}
+					case "timestamp":
+						if value.GetTimestampValue() == nil {
+							continue
+						}
+						switch qual.Operator {
+						case "=", ">", ">=", "<", "<=":
+							filters = append(filters, fmt.Sprintf("(%s %s \"%s\")", filterQualItem.PropertyPath, qual.Operator, value.GetTimestampValue().AsTime().Format(time.RFC3339Nano)))
+						}
 					}
 				}
 			}
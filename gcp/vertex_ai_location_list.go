@@ -48,6 +48,9 @@ func BuildVertexAILocationList(clientType string) func(ctx context.Context, d *p
 		case "Dataset":
 			resp := service.Dataset.ListLocations(ctx, input)
 			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
+		case "Featurestore":
+			resp := service.Featurestore.ListLocations(ctx, input)
+			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
 		case "Index":
 			resp := service.Index.ListLocations(ctx, input)
 			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
@@ -60,6 +63,9 @@ func BuildVertexAILocationList(clientType string) func(ctx context.Context, d *p
 		case "Notebook":
 			resp := service.Notebook.ListLocations(ctx, input)
 			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
+		case "Pipeline":
+			resp := service.Pipeline.ListLocations(ctx, input)
+			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
 		}
 
 		// validate location list